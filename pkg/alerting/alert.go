@@ -0,0 +1,14 @@
+package alerting
+
+import "time"
+
+// Alert is one rule's evaluation result for a single series (eg. one
+// validator on one chain), shaped to match what Alertmanager v2's
+// /api/v2/alerts endpoint expects.
+type Alert struct {
+	Fingerprint  string
+	Labels       map[string]string
+	Annotations  map[string]string
+	StartsAt     time.Time
+	GeneratorURL string
+}
@@ -0,0 +1,150 @@
+package alerting
+
+import (
+	"context"
+	"log/slog"
+	"main/pkg/config"
+	statePkg "main/pkg/state"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type trackedAlert struct {
+	alert    Alert
+	lastSent time.Time
+}
+
+// Dispatcher runs every built-in Rule over the exporter state after each
+// fetch/generate cycle and POSTs firing/resolved alerts straight to one
+// or more Alertmanager v2 endpoints, so users who don't run Prometheus
+// rule evaluation still get pager-grade signal from the exporter alone.
+//
+// An alert is re-sent every RepeatInterval while it keeps firing, and a
+// "resolved" update is sent the first cycle it stops appearing. On top
+// of that, GroupInterval bounds how often a batch actually goes out at
+// all - if Evaluate runs again before GroupInterval has elapsed since
+// the last dispatch, it leaves state untouched and tries again next
+// cycle, the same batching Alertmanager's own group_interval applies to
+// a notification group.
+type Dispatcher struct {
+	Logger         *slog.Logger
+	Rules          []Rule
+	Endpoints      []string
+	GroupInterval  time.Duration
+	RepeatInterval time.Duration
+	GeneratorURL   string
+	Client         *http.Client
+
+	mutex        sync.Mutex
+	firing       map[string]*trackedAlert
+	lastDispatch time.Time
+}
+
+func NewDispatcher(logger *slog.Logger, cfg config.AlertingConfig, rules []Rule) *Dispatcher {
+	return &Dispatcher{
+		Logger:         logger.With("component", "alerting_dispatcher"),
+		Rules:          rules,
+		Endpoints:      cfg.AlertmanagerURLs,
+		GroupInterval:  cfg.GroupInterval,
+		RepeatInterval: cfg.RepeatInterval,
+		GeneratorURL:   cfg.GeneratorURL,
+		Client:         &http.Client{Timeout: 10 * time.Second},
+		firing:         map[string]*trackedAlert{},
+	}
+}
+
+// dispatchTimeout bounds an EvaluateAsync run, independent of whatever
+// context (and deadline) the /metrics request that triggered it has.
+const dispatchTimeout = 30 * time.Second
+
+// EvaluateAsync runs Evaluate on its own goroutine with a bounded context
+// of its own, so a slow or unreachable Alertmanager never blocks the
+// scrape or push cycle that called it.
+func (d *Dispatcher) EvaluateAsync(state *statePkg.State) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), dispatchTimeout)
+		defer cancel()
+
+		d.Evaluate(ctx, state)
+	}()
+}
+
+// Evaluate runs every rule over state and dispatches whatever alerts
+// need sending this cycle.
+func (d *Dispatcher) Evaluate(ctx context.Context, state *statePkg.State) {
+	if len(d.Endpoints) == 0 {
+		return
+	}
+
+	current := map[string]Alert{}
+	for _, rule := range d.Rules {
+		for _, alert := range rule.Evaluate(state) {
+			if alert.GeneratorURL == "" {
+				alert.GeneratorURL = d.GeneratorURL
+			}
+
+			current[alert.Fingerprint] = alert
+		}
+	}
+
+	toSend, ready := d.reconcile(current)
+	if !ready || len(toSend) == 0 {
+		return
+	}
+
+	for _, endpoint := range d.Endpoints {
+		if err := postAlerts(ctx, d.Client, endpoint, toSend); err != nil {
+			d.Logger.Error("Could not dispatch alerts to Alertmanager", "error", err, "url", endpoint)
+		}
+	}
+}
+
+// reconcile decides what needs sending and whether GroupInterval allows
+// sending it right now. When it isn't ready yet, it returns false without
+// touching firing state at all, so the exact same decision gets made
+// again next cycle instead of alerts being silently dropped.
+func (d *Dispatcher) reconcile(current map[string]Alert) (toSend []v2Alert, ready bool) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	now := time.Now()
+	if now.Sub(d.lastDispatch) < d.GroupInterval {
+		return nil, false
+	}
+
+	for fingerprint, alert := range current {
+		tracked, wasFiring := d.firing[fingerprint]
+
+		if !wasFiring || now.Sub(tracked.lastSent) >= d.RepeatInterval {
+			toSend = append(toSend, v2Alert{
+				Labels:       alert.Labels,
+				Annotations:  alert.Annotations,
+				StartsAt:     alert.StartsAt,
+				GeneratorURL: alert.GeneratorURL,
+			})
+			d.firing[fingerprint] = &trackedAlert{alert: alert, lastSent: now}
+		}
+	}
+
+	for fingerprint, tracked := range d.firing {
+		if _, stillFiring := current[fingerprint]; stillFiring {
+			continue
+		}
+
+		toSend = append(toSend, v2Alert{
+			Labels:       tracked.alert.Labels,
+			Annotations:  tracked.alert.Annotations,
+			StartsAt:     tracked.alert.StartsAt,
+			EndsAt:       now,
+			GeneratorURL: tracked.alert.GeneratorURL,
+		})
+		delete(d.firing, fingerprint)
+	}
+
+	if len(toSend) > 0 {
+		d.lastDispatch = now
+	}
+
+	return toSend, true
+}
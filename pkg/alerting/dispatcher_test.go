@@ -0,0 +1,84 @@
+package alerting
+
+import (
+	"testing"
+	"time"
+)
+
+func newTestDispatcher(groupInterval, repeatInterval time.Duration) *Dispatcher {
+	return &Dispatcher{
+		GroupInterval:  groupInterval,
+		RepeatInterval: repeatInterval,
+		firing:         map[string]*trackedAlert{},
+	}
+}
+
+func TestReconcileSendsNewAlertImmediately(t *testing.T) {
+	d := newTestDispatcher(0, time.Hour)
+
+	toSend, ready := d.reconcile(map[string]Alert{"a": {Fingerprint: "a"}})
+	if !ready {
+		t.Fatal("expected reconcile to be ready with a zero GroupInterval")
+	}
+
+	if len(toSend) != 1 {
+		t.Fatalf("expected 1 alert to send, got %d", len(toSend))
+	}
+}
+
+func TestReconcileWithinGroupIntervalIsNotReadyAndLeavesStateUntouched(t *testing.T) {
+	d := newTestDispatcher(time.Hour, time.Hour)
+	d.lastDispatch = time.Now()
+
+	toSend, ready := d.reconcile(map[string]Alert{"a": {Fingerprint: "a"}})
+	if ready {
+		t.Fatal("expected reconcile to not be ready within GroupInterval")
+	}
+
+	if toSend != nil {
+		t.Fatalf("expected no alerts when not ready, got %d", len(toSend))
+	}
+
+	if len(d.firing) != 0 {
+		t.Fatal("expected firing state to be untouched when not ready, so the same decision is made next cycle")
+	}
+}
+
+func TestReconcileDoesNotResendWithinRepeatInterval(t *testing.T) {
+	d := newTestDispatcher(0, time.Hour)
+
+	first, ready := d.reconcile(map[string]Alert{"a": {Fingerprint: "a"}})
+	if !ready || len(first) != 1 {
+		t.Fatalf("expected the first cycle to send, got ready=%v len=%d", ready, len(first))
+	}
+
+	second, ready := d.reconcile(map[string]Alert{"a": {Fingerprint: "a"}})
+	if !ready {
+		t.Fatal("expected the second cycle to be ready")
+	}
+
+	if len(second) != 0 {
+		t.Fatalf("expected no resend within RepeatInterval, got %d alerts", len(second))
+	}
+}
+
+func TestReconcileSendsResolvedOnceAlertStopsFiring(t *testing.T) {
+	d := newTestDispatcher(0, time.Hour)
+
+	if _, ready := d.reconcile(map[string]Alert{"a": {Fingerprint: "a"}}); !ready {
+		t.Fatal("expected the first cycle to be ready")
+	}
+
+	toSend, ready := d.reconcile(map[string]Alert{})
+	if !ready {
+		t.Fatal("expected the resolve cycle to be ready")
+	}
+
+	if len(toSend) != 1 || toSend[0].EndsAt.IsZero() {
+		t.Fatalf("expected exactly 1 resolved alert with EndsAt set, got %+v", toSend)
+	}
+
+	if len(d.firing) != 0 {
+		t.Fatal("expected the alert to be cleared from firing state once resolved")
+	}
+}
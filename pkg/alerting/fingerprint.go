@@ -0,0 +1,16 @@
+package alerting
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// fingerprint derives a stable identity for an alert series out of a rule
+// name plus whatever dimensions identify it (chain, validator, ...), so
+// the Dispatcher can tell "still firing" apart from "a new alert".
+func fingerprint(parts ...string) string {
+	hash := sha256.New()
+	hash.Write([]byte(strings.Join(parts, "|")))
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,13 @@
+package alerting
+
+import statePkg "main/pkg/state"
+
+// Rule evaluates the current exporter state and returns the alerts that
+// are firing right now. A rule does not need to track what it fired
+// last cycle - the Dispatcher diffs against the previous evaluation and
+// emits the matching "resolved" update once a returned alert stops
+// appearing.
+type Rule interface {
+	Name() string
+	Evaluate(state *statePkg.State) []Alert
+}
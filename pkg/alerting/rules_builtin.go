@@ -0,0 +1,363 @@
+package alerting
+
+import (
+	"fmt"
+	"main/pkg/config"
+	"main/pkg/constants"
+	fetchersPkg "main/pkg/fetchers"
+	statePkg "main/pkg/state"
+	"sync"
+	"time"
+)
+
+// thresholdFor resolves a per-chain override over a global default,
+// following the same override shape config.Chain already uses elsewhere.
+func thresholdFor[T any](global T, overrides map[string]T, chain string) T {
+	if override, ok := overrides[chain]; ok {
+		return override
+	}
+
+	return global
+}
+
+func baseLabels(alertname, chain, validator, severity string) map[string]string {
+	return map[string]string{
+		"alertname": alertname,
+		"chain":     chain,
+		"validator": validator,
+		"severity":  severity,
+	}
+}
+
+// JailedRule fires while a validator is jailed.
+type JailedRule struct{}
+
+func NewJailedRule() *JailedRule { return &JailedRule{} }
+
+func (r *JailedRule) Name() string { return "ValidatorJailed" }
+
+func (r *JailedRule) Evaluate(state *statePkg.State) []Alert {
+	data, ok := statePkg.StateGet[fetchersPkg.SigningInfoData](state, constants.FetcherNameSigningInfo)
+	if !ok {
+		return nil
+	}
+
+	var alerts []Alert
+	for chain, perValidator := range data.Infos {
+		for validator, info := range perValidator {
+			if info == nil || !info.Jailed {
+				continue
+			}
+
+			alerts = append(alerts, Alert{
+				Fingerprint: fingerprint(r.Name(), chain, validator),
+				Labels:      baseLabels(r.Name(), chain, validator, "critical"),
+				Annotations: map[string]string{
+					"summary": fmt.Sprintf("Validator %s on %s is jailed", validator, chain),
+				},
+				StartsAt: time.Now(),
+			})
+		}
+	}
+
+	return alerts
+}
+
+// TombstonedRule fires once and stays firing for a validator that has
+// been tombstoned - there is no coming back from that, so it's the
+// highest-severity signal this exporter can raise.
+type TombstonedRule struct{}
+
+func NewTombstonedRule() *TombstonedRule { return &TombstonedRule{} }
+
+func (r *TombstonedRule) Name() string { return "ValidatorTombstoned" }
+
+func (r *TombstonedRule) Evaluate(state *statePkg.State) []Alert {
+	data, ok := statePkg.StateGet[fetchersPkg.SigningInfoData](state, constants.FetcherNameSigningInfo)
+	if !ok {
+		return nil
+	}
+
+	var alerts []Alert
+	for chain, perValidator := range data.Infos {
+		for validator, info := range perValidator {
+			if info == nil || !info.Tombstoned {
+				continue
+			}
+
+			alerts = append(alerts, Alert{
+				Fingerprint: fingerprint(r.Name(), chain, validator),
+				Labels:      baseLabels(r.Name(), chain, validator, "critical"),
+				Annotations: map[string]string{
+					"summary": fmt.Sprintf("Validator %s on %s is tombstoned", validator, chain),
+				},
+				StartsAt: time.Now(),
+			})
+		}
+	}
+
+	return alerts
+}
+
+// MissedBlocksRule fires while a validator's missed-blocks counter is
+// above config.AlertingConfig.MissedBlocksThreshold (or its per-chain
+// override).
+type MissedBlocksRule struct {
+	Threshold int64
+	Overrides map[string]int64
+}
+
+func NewMissedBlocksRule(cfg config.AlertingConfig) *MissedBlocksRule {
+	return &MissedBlocksRule{Threshold: cfg.MissedBlocksThreshold, Overrides: cfg.MissedBlocksThresholdOverrides}
+}
+
+func (r *MissedBlocksRule) Name() string { return "ValidatorMissedBlocks" }
+
+func (r *MissedBlocksRule) Evaluate(state *statePkg.State) []Alert {
+	data, ok := statePkg.StateGet[fetchersPkg.SigningInfoData](state, constants.FetcherNameSigningInfo)
+	if !ok {
+		return nil
+	}
+
+	var alerts []Alert
+	for chain, perValidator := range data.Infos {
+		threshold := thresholdFor(r.Threshold, r.Overrides, chain)
+
+		for validator, info := range perValidator {
+			if info == nil || info.MissedBlocksCounter <= threshold {
+				continue
+			}
+
+			alerts = append(alerts, Alert{
+				Fingerprint: fingerprint(r.Name(), chain, validator),
+				Labels:      baseLabels(r.Name(), chain, validator, "warning"),
+				Annotations: map[string]string{
+					"summary": fmt.Sprintf(
+						"Validator %s on %s missed %d blocks, over the %d threshold",
+						validator, chain, info.MissedBlocksCounter, threshold,
+					),
+				},
+				StartsAt: time.Now(),
+			})
+		}
+	}
+
+	return alerts
+}
+
+// CommissionChangedRule fires for one cycle whenever a validator's
+// commission rate differs from the last time this rule ran - operators
+// want to know the moment it moves, not just whenever someone happens
+// to be watching the dashboard.
+type CommissionChangedRule struct {
+	mutex    sync.Mutex
+	previous map[string]map[string]float64
+}
+
+func NewCommissionChangedRule() *CommissionChangedRule {
+	return &CommissionChangedRule{previous: map[string]map[string]float64{}}
+}
+
+func (r *CommissionChangedRule) Name() string { return "ValidatorCommissionChanged" }
+
+func (r *CommissionChangedRule) Evaluate(state *statePkg.State) []Alert {
+	data, ok := statePkg.StateGet[fetchersPkg.CommissionData](state, constants.FetcherNameCommission)
+	if !ok {
+		return nil
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var alerts []Alert
+	for chain, perValidator := range data.Rates {
+		if r.previous[chain] == nil {
+			r.previous[chain] = map[string]float64{}
+		}
+
+		for validator, rate := range perValidator {
+			previous, seen := r.previous[chain][validator]
+			r.previous[chain][validator] = rate
+
+			if !seen || previous == rate {
+				continue
+			}
+
+			alerts = append(alerts, Alert{
+				Fingerprint: fingerprint(r.Name(), chain, validator),
+				Labels:      baseLabels(r.Name(), chain, validator, "warning"),
+				Annotations: map[string]string{
+					"summary": fmt.Sprintf(
+						"Validator %s on %s changed commission from %.4f to %.4f",
+						validator, chain, previous, rate,
+					),
+				},
+				StartsAt: time.Now(),
+			})
+		}
+	}
+
+	return alerts
+}
+
+// UnbondingQueueGrowthRule fires while a validator's unbonding
+// delegations count has grown since the last cycle by at least the
+// configured threshold - a sign delegators are leaving faster than
+// usual.
+type UnbondingQueueGrowthRule struct {
+	Threshold uint64
+	Overrides map[string]uint64
+
+	mutex    sync.Mutex
+	previous map[string]map[string]uint64
+}
+
+func NewUnbondingQueueGrowthRule(cfg config.AlertingConfig) *UnbondingQueueGrowthRule {
+	return &UnbondingQueueGrowthRule{
+		Threshold: cfg.UnbondingGrowthThreshold,
+		Overrides: cfg.UnbondingGrowthThresholdOverrides,
+		previous:  map[string]map[string]uint64{},
+	}
+}
+
+func (r *UnbondingQueueGrowthRule) Name() string { return "UnbondingQueueGrowth" }
+
+func (r *UnbondingQueueGrowthRule) Evaluate(state *statePkg.State) []Alert {
+	data, ok := statePkg.StateGet[fetchersPkg.UnbondsData](state, constants.FetcherNameUnbonds)
+	if !ok {
+		return nil
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var alerts []Alert
+	for chain, perValidator := range data.Unbonds {
+		threshold := thresholdFor(r.Threshold, r.Overrides, chain)
+
+		if r.previous[chain] == nil {
+			r.previous[chain] = map[string]uint64{}
+		}
+
+		for validator, count := range perValidator {
+			previous, seen := r.previous[chain][validator]
+			r.previous[chain][validator] = count
+
+			if !seen || count <= previous || count-previous < threshold {
+				continue
+			}
+
+			alerts = append(alerts, Alert{
+				Fingerprint: fingerprint(r.Name(), chain, validator),
+				Labels:      baseLabels(r.Name(), chain, validator, "warning"),
+				Annotations: map[string]string{
+					"summary": fmt.Sprintf(
+						"Validator %s on %s unbonding queue grew from %d to %d",
+						validator, chain, previous, count,
+					),
+				},
+				StartsAt: time.Now(),
+			})
+		}
+	}
+
+	return alerts
+}
+
+// BalanceBelowFeeFloorRule fires while a validator's operator balance is
+// below the configured fee floor, ie. it's at risk of being unable to
+// pay gas for its own transactions (claiming rewards, unjailing, ...).
+type BalanceBelowFeeFloorRule struct {
+	FeeFloor  float64
+	Overrides map[string]float64
+}
+
+func NewBalanceBelowFeeFloorRule(cfg config.AlertingConfig) *BalanceBelowFeeFloorRule {
+	return &BalanceBelowFeeFloorRule{FeeFloor: cfg.FeeFloor, Overrides: cfg.FeeFloorOverrides}
+}
+
+func (r *BalanceBelowFeeFloorRule) Name() string { return "ValidatorBalanceBelowFeeFloor" }
+
+func (r *BalanceBelowFeeFloorRule) Evaluate(state *statePkg.State) []Alert {
+	data, ok := statePkg.StateGet[fetchersPkg.BalanceData](state, constants.FetcherNameBalance)
+	if !ok {
+		return nil
+	}
+
+	var alerts []Alert
+	for chain, perValidator := range data.Balances {
+		floor := thresholdFor(r.FeeFloor, r.Overrides, chain)
+
+		for validator, balance := range perValidator {
+			if balance >= floor {
+				continue
+			}
+
+			alerts = append(alerts, Alert{
+				Fingerprint: fingerprint(r.Name(), chain, validator),
+				Labels:      baseLabels(r.Name(), chain, validator, "warning"),
+				Annotations: map[string]string{
+					"summary": fmt.Sprintf(
+						"Validator %s on %s balance %.6f is below the %.6f fee floor",
+						validator, chain, balance, floor,
+					),
+				},
+				StartsAt: time.Now(),
+			})
+		}
+	}
+
+	return alerts
+}
+
+// PriceStaleRule fires while a chain's price feed hasn't updated within
+// config.AlertingConfig.PriceMaxAge, so operators relying on this
+// exporter alone notice a stuck price source before it silently skews
+// reward/balance value metrics.
+type PriceStaleRule struct {
+	MaxAge    time.Duration
+	Overrides map[string]time.Duration
+}
+
+func NewPriceStaleRule(cfg config.AlertingConfig) *PriceStaleRule {
+	return &PriceStaleRule{MaxAge: cfg.PriceMaxAge, Overrides: cfg.PriceMaxAgeOverrides}
+}
+
+func (r *PriceStaleRule) Name() string { return "PriceFeedStale" }
+
+func (r *PriceStaleRule) Evaluate(state *statePkg.State) []Alert {
+	data, ok := statePkg.StateGet[fetchersPkg.PriceData](state, constants.FetcherNamePrice)
+	if !ok {
+		return nil
+	}
+
+	var alerts []Alert
+	for chain, denoms := range data.Prices {
+		maxAge := thresholdFor(r.MaxAge, r.Overrides, chain)
+
+		for denom, price := range denoms {
+			if time.Since(price.UpdatedAt) <= maxAge {
+				continue
+			}
+
+			alerts = append(alerts, Alert{
+				Fingerprint: fingerprint(r.Name(), chain, denom),
+				Labels: map[string]string{
+					"alertname": r.Name(),
+					"chain":     chain,
+					"denom":     denom,
+					"severity":  "warning",
+				},
+				Annotations: map[string]string{
+					"summary": fmt.Sprintf(
+						"Price feed for %s on %s has not updated in over %s",
+						denom, chain, maxAge,
+					),
+				},
+				StartsAt: time.Now(),
+			})
+		}
+	}
+
+	return alerts
+}
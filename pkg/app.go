@@ -2,10 +2,15 @@ package pkg
 
 import (
 	"context"
+	"log/slog"
+	alertingPkg "main/pkg/alerting"
+	cachePkg "main/pkg/cache"
 	controllerPkg "main/pkg/controller"
 	fetchersPkg "main/pkg/fetchers"
+	oraclePkg "main/pkg/fetchers/oracle"
 	"main/pkg/fs"
 	generatorsPkg "main/pkg/generators"
+	remotewritePkg "main/pkg/remotewrite"
 	"main/pkg/tendermint"
 	"main/pkg/tracing"
 	"net/http"
@@ -15,19 +20,20 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 
 	"main/pkg/config"
+	"main/pkg/constants"
 	loggerPkg "main/pkg/logger"
 
 	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
-	"github.com/rs/zerolog"
+	dto "github.com/prometheus/client_model/go"
 	"go.opentelemetry.io/otel/trace"
 )
 
 type App struct {
 	Tracer trace.Tracer
 	Config *config.Config
-	Logger *zerolog.Logger
+	Logger *slog.Logger
 	Server *http.Server
 
 	RPCs map[string]*tendermint.RPCWithConsumers
@@ -44,27 +50,141 @@ type App struct {
 	Generators []generatorsPkg.Generator
 
 	Controller *controllerPkg.Controller
+
+	// CacheMetrics are the hit/miss counters of every cache-wrapped
+	// fetcher, registered into the registry on every scrape alongside
+	// the metrics the generators produce.
+	CacheMetrics []prometheus.Collector
+
+	// RateLimitScheduler hands out per-chain, per-endpoint limiters to
+	// the fetchers and oracle providers that were given it; fetchers
+	// constructed without it query their RPC unlimited. Its
+	// wait-time/in-flight/rejected metrics are gathered fresh on every
+	// scrape since limiters are created lazily.
+	RateLimitScheduler *tendermint.Scheduler
+
+	// Alerting dispatches firing/resolved alerts to Alertmanager after
+	// every fetch/generate cycle. Nil when no Alertmanager URL is
+	// configured.
+	Alerting *alertingPkg.Dispatcher
+
+	// OracleAggregator resolves configured on-chain oracle feeds for
+	// PriceFetcher, falling back to its fiat-API sources and exposing
+	// oracle-vs-market divergence as a metric.
+	OracleAggregator *oraclePkg.Aggregator
+}
+
+func newAlertingDispatcher(logger *slog.Logger, cfg config.AlertingConfig) *alertingPkg.Dispatcher {
+	if !cfg.Enabled || len(cfg.AlertmanagerURLs) == 0 {
+		return nil
+	}
+
+	rules := []alertingPkg.Rule{
+		alertingPkg.NewJailedRule(),
+		alertingPkg.NewTombstonedRule(),
+		alertingPkg.NewMissedBlocksRule(cfg),
+		alertingPkg.NewCommissionChangedRule(),
+		alertingPkg.NewUnbondingQueueGrowthRule(cfg),
+		alertingPkg.NewBalanceBelowFeeFloorRule(cfg),
+		alertingPkg.NewPriceStaleRule(cfg),
+	}
+
+	return alertingPkg.NewDispatcher(logger, cfg, rules)
+}
+
+// defaultCacheTTLs are used for a fetcher when config.CacheConfig does not
+// provide an explicit override, covering every fetcher so enabling the
+// cache actually caches the whole fetch cycle rather than just the three
+// busiest ones. Params-like data changes rarely, so it's cached the
+// longest; per-validator data changes often enough that a short TTL
+// still meaningfully cuts RPC load without serving stale values.
+var defaultCacheTTLs = map[constants.FetcherName]time.Duration{
+	constants.FetcherNameStakingParams:      time.Hour,
+	constants.FetcherNameSlashingParams:     time.Hour,
+	constants.FetcherNamePrice:              5 * time.Minute,
+	constants.FetcherNameValidators:         30 * time.Second,
+	constants.FetcherNameConsumerValidators: 30 * time.Second,
+	constants.FetcherNameCommission:         time.Minute,
+	constants.FetcherNameConsumerCommission: time.Minute,
+	constants.FetcherNameDelegations:        time.Minute,
+	constants.FetcherNameSelfDelegation:     time.Minute,
+	constants.FetcherNameUnbonds:            time.Minute,
+	constants.FetcherNameSigningInfo:        30 * time.Second,
+	constants.FetcherNameRewards:            time.Minute,
+	constants.FetcherNameBalance:            30 * time.Second,
+	constants.FetcherNameNodeInfo:           time.Minute,
+	constants.FetcherNameConsumerInfo:       time.Minute,
+	constants.FetcherNameValidatorConsumers: time.Minute,
+	constants.FetcherNameInflation:          time.Hour,
+	constants.FetcherNameSupply:             time.Hour,
+}
+
+func newCacheBackend(logger *slog.Logger, cacheConfig config.CacheConfig) cachePkg.Cache {
+	if !cacheConfig.Enabled {
+		return nil
+	}
+
+	if cacheConfig.Redis.Addr != "" {
+		return cachePkg.NewRedisCache(
+			logger,
+			cacheConfig.Redis.Addr,
+			cacheConfig.Redis.Password,
+			cacheConfig.Redis.DB,
+			"cosmos-validators-exporter:",
+		)
+	}
+
+	return cachePkg.NewMemoryCache(cacheConfig.MaxBytes)
+}
+
+func wrapWithCache(
+	logger *slog.Logger,
+	fetchers []fetchersPkg.Fetcher,
+	cacheConfig config.CacheConfig,
+) ([]fetchersPkg.Fetcher, []prometheus.Collector) {
+	backend := newCacheBackend(logger, cacheConfig)
+	if backend == nil {
+		return fetchers, nil
+	}
+
+	wrapped := make([]fetchersPkg.Fetcher, len(fetchers))
+	var metrics []prometheus.Collector
+
+	for i, fetcher := range fetchers {
+		ttl := defaultCacheTTLs[fetcher.Name()]
+		if override, ok := cacheConfig.TTLs[string(fetcher.Name())]; ok {
+			ttl = override
+		}
+
+		cached := cachePkg.Wrap(fetcher, backend, ttl)
+		wrapped[i] = cached
+		metrics = append(metrics, cached.Metrics()...)
+	}
+
+	return wrapped, metrics
 }
 
 func NewApp(configPath string, filesystem fs.FS, version string) *App {
 	appConfig, err := config.GetConfig(configPath, filesystem)
 	if err != nil {
-		loggerPkg.GetDefaultLogger().Panic().Err(err).Msg("Could not load config")
+		loggerPkg.GetDefaultLogger().Error("Could not load config", "error", err)
+		panic(err)
 	}
 
 	if err = appConfig.Validate(); err != nil {
-		loggerPkg.GetDefaultLogger().Panic().Err(err).Msg("Provided config is invalid!")
+		loggerPkg.GetDefaultLogger().Error("Provided config is invalid!", "error", err)
+		panic(err)
 	}
 
 	logger := loggerPkg.GetLogger(appConfig.LogConfig)
 	warnings := appConfig.DisplayWarnings()
 	for _, warning := range warnings {
-		entry := logger.Warn()
+		args := make([]any, 0, len(warning.Labels)*2)
 		for label, value := range warning.Labels {
-			entry = entry.Str(label, value)
+			args = append(args, label, value)
 		}
 
-		entry.Msg(warning.Message)
+		logger.Warn(warning.Message, args...)
 	}
 
 	tracer := tracing.InitTracer(appConfig.TracingConfig, version)
@@ -72,30 +192,53 @@ func NewApp(configPath string, filesystem fs.FS, version string) *App {
 	rpcs := make(map[string]*tendermint.RPCWithConsumers, len(appConfig.Chains))
 
 	for _, chain := range appConfig.Chains {
-		rpcs[chain.Name] = tendermint.RPCWithConsumersFromChain(chain, appConfig.Timeout, *logger, tracer)
+		rpcs[chain.Name] = tendermint.RPCWithConsumersFromChain(chain, appConfig.Timeout, logger, tracer)
 	}
 
+	scheduler := tendermint.NewScheduler(appConfig.RateLimit)
+	oracleAggregator := oraclePkg.NewAggregator(logger, rpcs, scheduler)
+
 	fetchers := []fetchersPkg.Fetcher{
 		fetchersPkg.NewSlashingParamsFetcher(logger, appConfig.Chains, rpcs, tracer),
 		fetchersPkg.NewCommissionFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewDelegationsFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewUnbondsFetcher(logger, appConfig.Chains, rpcs, tracer),
+		fetchersPkg.NewDelegationsFetcher(logger, appConfig.Chains, rpcs, tracer, scheduler),
+		fetchersPkg.NewUnbondsFetcher(logger, appConfig.Chains, rpcs, tracer, scheduler),
 		fetchersPkg.NewSigningInfoFetcher(logger, appConfig.Chains, rpcs, tracer),
 		fetchersPkg.NewRewardsFetcher(logger, appConfig.Chains, rpcs, tracer),
 		fetchersPkg.NewBalanceFetcher(logger, appConfig.Chains, rpcs, tracer),
 		fetchersPkg.NewSelfDelegationFetcher(logger, appConfig.Chains, rpcs, tracer),
 		fetchersPkg.NewValidatorsFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewConsumerValidatorsFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewStakingParamsFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewPriceFetcher(logger, appConfig, tracer),
+		fetchersPkg.NewConsumerValidatorsFetcher(logger, appConfig.Chains, rpcs, tracer, scheduler),
+		fetchersPkg.NewStakingParamsFetcher(logger, appConfig.Chains, rpcs, tracer, scheduler),
+		fetchersPkg.NewPriceFetcher(logger, appConfig, tracer, oracleAggregator),
 		fetchersPkg.NewNodeInfoFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewConsumerInfoFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewValidatorConsumersFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewConsumerCommissionFetcher(logger, appConfig.Chains, rpcs, tracer),
+		fetchersPkg.NewConsumerInfoFetcher(logger, appConfig.Chains, rpcs, tracer, scheduler),
+		fetchersPkg.NewValidatorConsumersFetcher(logger, appConfig.Chains, rpcs, tracer, scheduler),
+		fetchersPkg.NewConsumerCommissionFetcher(logger, appConfig.Chains, rpcs, tracer, scheduler),
 		fetchersPkg.NewInflationFetcher(logger, appConfig.Chains, rpcs, tracer),
 		fetchersPkg.NewSupplyFetcher(logger, appConfig.Chains, rpcs, tracer),
 	}
 
+	cachePkg.RegisterType(fetchersPkg.StakingParamsData{})
+	cachePkg.RegisterType(fetchersPkg.SlashingParamsData{})
+	cachePkg.RegisterType(fetchersPkg.PriceData{})
+	cachePkg.RegisterType(fetchersPkg.UnbondsData{})
+	cachePkg.RegisterType(fetchersPkg.ValidatorsData{})
+	cachePkg.RegisterType(fetchersPkg.ConsumerValidatorsData{})
+	cachePkg.RegisterType(fetchersPkg.CommissionData{})
+	cachePkg.RegisterType(fetchersPkg.ConsumerCommissionData{})
+	cachePkg.RegisterType(fetchersPkg.DelegationsData{})
+	cachePkg.RegisterType(fetchersPkg.SelfDelegationData{})
+	cachePkg.RegisterType(fetchersPkg.SigningInfoData{})
+	cachePkg.RegisterType(fetchersPkg.RewardsData{})
+	cachePkg.RegisterType(fetchersPkg.BalanceData{})
+	cachePkg.RegisterType(fetchersPkg.NodeInfoData{})
+	cachePkg.RegisterType(fetchersPkg.ConsumerInfoData{})
+	cachePkg.RegisterType(fetchersPkg.ValidatorConsumersData{})
+	cachePkg.RegisterType(fetchersPkg.InflationData{})
+	cachePkg.RegisterType(fetchersPkg.SupplyData{})
+	fetchers, cacheMetrics := wrapWithCache(logger, fetchers, appConfig.Cache)
+
 	generators := []generatorsPkg.Generator{
 		generatorsPkg.NewSlashingParamsGenerator(),
 		generatorsPkg.NewIsConsumerGenerator(appConfig.Chains),
@@ -127,14 +270,18 @@ func NewApp(configPath string, filesystem fs.FS, version string) *App {
 	server := &http.Server{Addr: appConfig.ListenAddress, Handler: nil}
 
 	return &App{
-		Logger:     logger,
-		Config:     appConfig,
-		Tracer:     tracer,
-		RPCs:       rpcs,
-		Fetchers:   fetchers,
-		Generators: generators,
-		Server:     server,
-		Controller: controller,
+		Logger:             logger,
+		Config:             appConfig,
+		Tracer:             tracer,
+		RPCs:               rpcs,
+		Fetchers:           fetchers,
+		Generators:         generators,
+		Server:             server,
+		Controller:         controller,
+		CacheMetrics:       cacheMetrics,
+		RateLimitScheduler: scheduler,
+		Alerting:           newAlertingDispatcher(logger, appConfig.Alerting),
+		OracleAggregator:   oracleAggregator,
 	}
 }
 
@@ -143,18 +290,20 @@ func (a *App) Start() {
 	handler := http.NewServeMux()
 	handler.Handle("/metrics", otelHandler)
 	handler.HandleFunc("/healthcheck", a.Healthcheck)
+	handler.HandleFunc("/log/level", loggerPkg.LevelHandler)
 	a.Server.Handler = handler
 
-	a.Logger.Info().Str("addr", a.Config.ListenAddress).Msg("Listening")
+	a.Logger.Info("Listening", "addr", a.Config.ListenAddress)
 
 	err := a.Server.ListenAndServe()
 	if err != nil {
-		a.Logger.Panic().Err(err).Msg("Could not start application")
+		a.Logger.Error("Could not start application", "error", err)
+		panic(err)
 	}
 }
 
 func (a *App) Stop() {
-	a.Logger.Info().Str("addr", a.Config.ListenAddress).Msg("Shutting down server...")
+	a.Logger.Info("Shutting down server...", "addr", a.Config.ListenAddress)
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	_ = a.Server.Shutdown(ctx)
@@ -167,36 +316,74 @@ func (a *App) Handler(w http.ResponseWriter, r *http.Request) {
 	span.SetAttributes(attribute.String("request-id", requestID))
 	rootSpanCtx := r.Context()
 
+	if r.URL.Query().Get("nocache") == "1" {
+		rootSpanCtx = cachePkg.WithBypass(rootSpanCtx)
+	}
+
 	defer span.End()
 
 	requestStart := time.Now()
 
-	sublogger := a.Logger.With().
-		Str("request-id", requestID).
-		Logger()
+	sublogger := a.Logger.With("request-id", requestID)
+
+	registry := a.Gather(rootSpanCtx)
+
+	h := promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+	h.ServeHTTP(w, r)
+
+	sublogger.Info(
+		"Request processed",
+		"method", http.MethodGet,
+		"endpoint", "/metrics",
+		"request-time", time.Since(requestStart).Seconds(),
+	)
+}
+
+func (a *App) Healthcheck(w http.ResponseWriter, r *http.Request) {
+	_, _ = w.Write([]byte("ok"))
+}
 
+// Gather runs the fetch + generate pipeline once and returns a freshly
+// populated registry, shared by the scrape Handler and the remote-write
+// push loop so both modes run the same pipeline. Push mode sends only the
+// gauge, counter and untyped series of it; histograms and summaries
+// appear on /metrics alone.
+func (a *App) Gather(ctx context.Context) *prometheus.Registry {
 	registry := prometheus.NewRegistry()
+	registry.MustRegister(a.CacheMetrics...)
+	registry.MustRegister(a.RateLimitScheduler.Metrics()...)
+	registry.MustRegister(a.OracleAggregator.Metrics()...)
+
+	state, queryInfos := a.Controller.Fetch(ctx)
 
-	state, queryInfos := a.Controller.Fetch(rootSpanCtx)
+	if a.Alerting != nil {
+		a.Alerting.EvaluateAsync(state)
+	}
 
 	queriesMetrics := NewQueriesMetrics(a.Config.Chains, queryInfos)
-	registry.MustRegister(queriesMetrics.GetMetrics(rootSpanCtx)...)
+	registry.MustRegister(queriesMetrics.GetMetrics(ctx)...)
 
 	for _, generator := range a.Generators {
-		metrics := generator.Generate(state)
-		registry.MustRegister(metrics...)
+		registry.MustRegister(generator.Generate(state)...)
 	}
 
-	h := promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
-	h.ServeHTTP(w, r)
-
-	sublogger.Info().
-		Str("method", http.MethodGet).
-		Str("endpoint", "/metrics").
-		Float64("request-time", time.Since(requestStart).Seconds()).
-		Msg("Request processed")
+	return registry
 }
 
-func (a *App) Healthcheck(w http.ResponseWriter, r *http.Request) {
-	_, _ = w.Write([]byte("ok"))
+// StartPush runs the fetch + generate pipeline on Config.RemoteWrite's
+// interval and ships the result to the configured remote-write
+// endpoints instead of (or alongside) serving /metrics. It blocks until
+// ctx is canceled.
+func (a *App) StartPush(ctx context.Context) {
+	if !a.Config.RemoteWrite.Enabled {
+		return
+	}
+
+	pusher := remotewritePkg.NewPusher(a.Logger, a.Config.RemoteWrite)
+
+	gatherer := prometheus.GathererFunc(func() ([]*dto.MetricFamily, error) {
+		return a.Gather(ctx).Gather()
+	})
+
+	pusher.Run(ctx, gatherer)
 }
@@ -0,0 +1,47 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"fmt"
+	"main/pkg/types"
+	"time"
+)
+
+// Entry is a single cached fetcher result: the raw value a Fetcher.Fetch
+// would have returned, the query infos it produced, and when it expires.
+type Entry struct {
+	Value   interface{}
+	Queries []*types.QueryInfo
+	Expiry  time.Time
+}
+
+// Cache is the storage backend a CachedFetcher reads from and writes to.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(key string) (*Entry, bool)
+	Set(key string, entry *Entry, ttl time.Duration)
+}
+
+// RegisterType makes a fetcher's data struct (eg. StakingParamsData)
+// known to encoding/gob, which the Redis backend relies on to serialize
+// Entry.Value. It must be called once per concrete data type before any
+// value of that type is stored via a gob-backed Cache.
+func RegisterType(value interface{}) {
+	gob.Register(value)
+}
+
+// BuildKey derives a stable cache key from a fetcher name and its
+// dependency arguments, so two calls with the same fetcher and the same
+// inputs land on the same cache entry.
+func BuildKey(name string, data ...interface{}) string {
+	hash := sha256.New()
+	hash.Write([]byte(name))
+
+	for _, item := range data {
+		_, _ = fmt.Fprintf(hash, "|%#v", item)
+	}
+
+	return name + ":" + hex.EncodeToString(hash.Sum(nil))
+}
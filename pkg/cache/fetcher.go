@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"context"
+	"main/pkg/constants"
+	fetchersPkg "main/pkg/fetchers"
+	"main/pkg/types"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type bypassKey struct{}
+
+// WithBypass marks a context so any CachedFetcher asked to Fetch with it
+// skips the cache entirely, matching the /metrics?nocache=1 query param.
+func WithBypass(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassKey{}, true)
+}
+
+func bypassRequested(ctx context.Context) bool {
+	bypass, _ := ctx.Value(bypassKey{}).(bool)
+	return bypass
+}
+
+// CachedFetcher wraps a fetchersPkg.Fetcher with a TTL-bound Cache,
+// keyed by the fetcher's name plus a hash of its dependency arguments.
+// It implements fetchersPkg.Fetcher itself, so it can be dropped straight
+// into App.Fetchers in place of the fetcher it wraps.
+type CachedFetcher struct {
+	fetcher fetchersPkg.Fetcher
+	cache   Cache
+	ttl     time.Duration
+
+	hits   prometheus.Counter
+	misses prometheus.Counter
+}
+
+func Wrap(fetcher fetchersPkg.Fetcher, backend Cache, ttl time.Duration) *CachedFetcher {
+	name := string(fetcher.Name())
+
+	return &CachedFetcher{
+		fetcher: fetcher,
+		cache:   backend,
+		ttl:     ttl,
+		hits: prometheus.NewCounter(prometheus.CounterOpts{
+			Name:        constants.MetricsPrefix + "cache_hits_total",
+			Help:        "Count of cache hits, per fetcher",
+			ConstLabels: prometheus.Labels{"fetcher": name},
+		}),
+		misses: prometheus.NewCounter(prometheus.CounterOpts{
+			Name:        constants.MetricsPrefix + "cache_misses_total",
+			Help:        "Count of cache misses, per fetcher",
+			ConstLabels: prometheus.Labels{"fetcher": name},
+		}),
+	}
+}
+
+func (c *CachedFetcher) Dependencies() []constants.FetcherName {
+	return c.fetcher.Dependencies()
+}
+
+func (c *CachedFetcher) Name() constants.FetcherName {
+	return c.fetcher.Name()
+}
+
+// Metrics returns the hit/miss counters so callers can register them
+// alongside the metrics the wrapped fetcher's generators produce.
+func (c *CachedFetcher) Metrics() []prometheus.Collector {
+	return []prometheus.Collector{c.hits, c.misses}
+}
+
+func (c *CachedFetcher) Fetch(ctx context.Context, data ...interface{}) (interface{}, []*types.QueryInfo) {
+	if c.cache == nil || c.ttl <= 0 || bypassRequested(ctx) {
+		return c.fetcher.Fetch(ctx, data...)
+	}
+
+	key := BuildKey(string(c.fetcher.Name()), data...)
+
+	if entry, ok := c.cache.Get(key); ok {
+		c.hits.Inc()
+		return entry.Value, entry.Queries
+	}
+
+	c.misses.Inc()
+
+	value, queries := c.fetcher.Fetch(ctx, data...)
+	c.cache.Set(key, &Entry{Value: value, Queries: queries}, c.ttl)
+
+	return value, queries
+}
@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+	"time"
+)
+
+type memoryItem struct {
+	key     string
+	entry   *Entry
+	bytes   int
+	element *list.Element
+}
+
+// MemoryCache is an in-process LRU cache with a per-entry TTL and a total
+// byte-size cap. Entries are evicted oldest-accessed-first once the cap
+// is exceeded, so a handful of large fetcher results can't starve the
+// rest of the cache.
+type MemoryCache struct {
+	mutex     sync.Mutex
+	items     map[string]*memoryItem
+	order     *list.List
+	maxBytes  int
+	usedBytes int
+}
+
+func NewMemoryCache(maxBytes int) *MemoryCache {
+	return &MemoryCache{
+		items:    make(map[string]*memoryItem),
+		order:    list.New(),
+		maxBytes: maxBytes,
+	}
+}
+
+func (c *MemoryCache) Get(key string) (*Entry, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	item, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	if time.Now().After(item.entry.Expiry) {
+		c.evictLocked(item)
+		return nil, false
+	}
+
+	c.order.MoveToFront(item.element)
+	return item.entry, true
+}
+
+func (c *MemoryCache) Set(key string, entry *Entry, ttl time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry.Expiry = time.Now().Add(ttl)
+
+	if existing, ok := c.items[key]; ok {
+		c.evictLocked(existing)
+	}
+
+	size := estimateSize(entry)
+	element := c.order.PushFront(key)
+	c.items[key] = &memoryItem{key: key, entry: entry, bytes: size, element: element}
+	c.usedBytes += size
+
+	for c.maxBytes > 0 && c.usedBytes > c.maxBytes && c.order.Len() > 0 {
+		oldest := c.order.Back()
+		oldestKey, _ := oldest.Value.(string)
+
+		if oldestItem, ok := c.items[oldestKey]; ok {
+			c.evictLocked(oldestItem)
+		}
+	}
+}
+
+func (c *MemoryCache) evictLocked(item *memoryItem) {
+	c.order.Remove(item.element)
+	delete(c.items, item.key)
+	c.usedBytes -= item.bytes
+}
+
+// estimateSize gives a rough byte size for an entry, good enough to keep
+// the LRU cap in the right ballpark without paying for a real encode on
+// every Set.
+func estimateSize(entry *Entry) int {
+	return len(fmt.Sprintf("%#v", entry.Value))
+}
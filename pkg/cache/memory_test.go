@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMemoryCacheGetMissOnExpiry(t *testing.T) {
+	cache := NewMemoryCache(0)
+	cache.Set("key", &Entry{Value: "value"}, -time.Second)
+
+	if _, ok := cache.Get("key"); ok {
+		t.Fatal("expected an already-expired entry to miss")
+	}
+}
+
+func TestMemoryCacheGetHitBeforeExpiry(t *testing.T) {
+	cache := NewMemoryCache(0)
+	cache.Set("key", &Entry{Value: "value"}, time.Minute)
+
+	entry, ok := cache.Get("key")
+	if !ok {
+		t.Fatal("expected a hit for a live entry")
+	}
+
+	if entry.Value != "value" {
+		t.Fatalf("expected value %q, got %q", "value", entry.Value)
+	}
+}
+
+func TestMemoryCacheEvictsOldestOverMaxBytes(t *testing.T) {
+	// estimateSize renders entry.Value with fmt.Sprintf("%#v", ...); a
+	// one-character string costs 3 bytes (quoted), so a 5-byte cap fits
+	// exactly one entry but not two.
+	cache := NewMemoryCache(5)
+	cache.Set("first", &Entry{Value: "a"}, time.Minute)
+	cache.Set("second", &Entry{Value: "b"}, time.Minute)
+
+	if _, ok := cache.Get("first"); ok {
+		t.Fatal("expected the oldest entry to be evicted once the byte cap was exceeded")
+	}
+
+	if _, ok := cache.Get("second"); !ok {
+		t.Fatal("expected the most recently set entry to still be present")
+	}
+}
@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisCache stores entries in Redis so multiple exporter replicas share
+// the same fetcher results instead of each hammering the RPC endpoints
+// independently. Entries are gob-encoded; RegisterType must be called for
+// every concrete Fetcher data type before it is stored here.
+type RedisCache struct {
+	client *redis.Client
+	prefix string
+	logger *slog.Logger
+}
+
+func NewRedisCache(logger *slog.Logger, addr string, password string, db int, keyPrefix string) *RedisCache {
+	return &RedisCache{
+		client: redis.NewClient(&redis.Options{
+			Addr:     addr,
+			Password: password,
+			DB:       db,
+		}),
+		prefix: keyPrefix,
+		logger: logger.With("component", "redis_cache"),
+	}
+}
+
+func (c *RedisCache) Get(key string) (*Entry, bool) {
+	raw, err := c.client.Get(context.Background(), c.prefix+key).Bytes()
+	if err != nil {
+		return nil, false
+	}
+
+	var entry Entry
+	if err := gob.NewDecoder(bytes.NewReader(raw)).Decode(&entry); err != nil {
+		return nil, false
+	}
+
+	if time.Now().After(entry.Expiry) {
+		return nil, false
+	}
+
+	return &entry, true
+}
+
+func (c *RedisCache) Set(key string, entry *Entry, ttl time.Duration) {
+	entry.Expiry = time.Now().Add(ttl)
+
+	buf := &bytes.Buffer{}
+	if err := gob.NewEncoder(buf).Encode(entry); err != nil {
+		// Most commonly a concrete type that was never passed to
+		// RegisterType - without this the entry silently never
+		// persists, so operators need to see it rather than guess.
+		c.logger.Error("Could not gob-encode cache entry, not caching this result", "error", err, "key", key)
+		return
+	}
+
+	c.client.Set(context.Background(), c.prefix+key, buf.Bytes(), ttl)
+}
@@ -0,0 +1,32 @@
+package config
+
+import "time"
+
+// AlertingConfig configures direct Alertmanager dispatch of the
+// built-in alerting rules, as an alternative to Prometheus rule
+// evaluation for users who run this exporter without a Prometheus
+// server watching it. It is a new field on Config: `Alerting
+// AlertingConfig`.
+type AlertingConfig struct {
+	Enabled          bool     `toml:"enabled"`
+	AlertmanagerURLs []string `toml:"alertmanager-urls"`
+
+	// GeneratorURL is attached to every alert so Alertmanager's UI can
+	// link back to whatever dashboard/exporter instance raised it.
+	GeneratorURL string `toml:"generator-url"`
+
+	GroupInterval  time.Duration `toml:"group-interval"`
+	RepeatInterval time.Duration `toml:"repeat-interval"`
+
+	MissedBlocksThreshold          int64            `toml:"missed-blocks-threshold"`
+	MissedBlocksThresholdOverrides map[string]int64 `toml:"missed-blocks-threshold-overrides"`
+
+	UnbondingGrowthThreshold          uint64            `toml:"unbonding-growth-threshold"`
+	UnbondingGrowthThresholdOverrides map[string]uint64 `toml:"unbonding-growth-threshold-overrides"`
+
+	FeeFloor          float64            `toml:"fee-floor"`
+	FeeFloorOverrides map[string]float64 `toml:"fee-floor-overrides"`
+
+	PriceMaxAge          time.Duration            `toml:"price-max-age"`
+	PriceMaxAgeOverrides map[string]time.Duration `toml:"price-max-age-overrides"`
+}
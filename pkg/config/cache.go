@@ -0,0 +1,31 @@
+package config
+
+import "time"
+
+// CacheConfig configures the per-fetcher result cache that wraps every
+// Fetcher with a TTL and an optional Redis-backed store so multiple
+// exporter replicas can share cached results instead of each hitting
+// the chain's RPC independently. It is a new field on Config: `Cache
+// CacheConfig`.
+type CacheConfig struct {
+	Enabled bool `toml:"enabled"`
+
+	// TTLs overrides the TTL for a specific fetcher, keyed by its
+	// constants.FetcherName. Any fetcher not listed here falls back to
+	// defaultCacheTTLs.
+	TTLs map[string]time.Duration `toml:"ttls"`
+
+	// MaxBytes caps the in-memory backend's total size. Zero means
+	// unbounded.
+	MaxBytes int `toml:"max-bytes"`
+
+	Redis RedisCacheConfig `toml:"redis"`
+}
+
+// RedisCacheConfig configures the optional Redis cache backend. Addr
+// left empty means the in-process MemoryCache is used instead.
+type RedisCacheConfig struct {
+	Addr     string `toml:"addr"`
+	Password string `toml:"password"`
+	DB       int    `toml:"db"`
+}
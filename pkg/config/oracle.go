@@ -0,0 +1,25 @@
+package config
+
+import "time"
+
+// OracleFeed configures one on-chain oracle price feed for a symbol on
+// a chain, resolved by oracle.Aggregator and falling back to the
+// existing fiat-API price when the on-chain source is missing or
+// stale. It is a new field on the existing Chain struct: `OracleFeeds
+// []OracleFeed`.
+type OracleFeed struct {
+	// Type selects the Provider to query: "band", "pyth" or "chainlink".
+	Type   string `toml:"type"`
+	Symbol string `toml:"symbol"`
+
+	// ContractAddr is the feed contract queried by the pyth and
+	// chainlink providers. RPCEndpoint is only used by the chainlink
+	// provider, which talks to an EVM chain directly rather than
+	// through this chain's own RPC.
+	ContractAddr string `toml:"contract-addr"`
+	RPCEndpoint  string `toml:"rpc-endpoint"`
+
+	// MaxAge is how stale an on-chain reading from any provider is
+	// allowed to be before it's treated as unavailable.
+	MaxAge time.Duration `toml:"max-age"`
+}
@@ -0,0 +1,24 @@
+package config
+
+// RateLimitConfig configures the per-chain, per-endpoint RPC rate
+// limiting applied by tendermint.Scheduler. Defaults apply unless a
+// chain or endpoint URL has an override; an endpoint override takes
+// precedence over a chain override. It is a new field on Config:
+// `RateLimit RateLimitConfig`.
+type RateLimitConfig struct {
+	DefaultQPS         float64 `toml:"default-qps"`
+	DefaultBurst       int     `toml:"default-burst"`
+	DefaultMaxInFlight int     `toml:"default-max-in-flight"`
+
+	PerChain    map[string]RateLimitOverride `toml:"per-chain"`
+	PerEndpoint map[string]RateLimitOverride `toml:"per-endpoint"`
+}
+
+// RateLimitOverride overrides one or more of RateLimitConfig's defaults
+// for a specific chain or endpoint URL. A zero field leaves the
+// corresponding default in place.
+type RateLimitOverride struct {
+	QPS         float64 `toml:"qps"`
+	Burst       int     `toml:"burst"`
+	MaxInFlight int     `toml:"max-in-flight"`
+}
@@ -0,0 +1,34 @@
+package config
+
+import "time"
+
+// RemoteWriteConfig configures pushing gathered metrics to one or more
+// Prometheus remote-write endpoints on a timer, as an alternative to
+// being scraped. It is a new field on Config: `RemoteWrite
+// RemoteWriteConfig`.
+type RemoteWriteConfig struct {
+	Enabled  bool          `toml:"enabled"`
+	Interval time.Duration `toml:"interval"`
+
+	// ExternalLabels is attached to every time series pushed, the same
+	// role external_labels plays in a Prometheus server's own config.
+	ExternalLabels map[string]string `toml:"external-labels"`
+
+	// WALMaxBatches bounds how many failed batches are kept in memory
+	// waiting for a retry before the oldest is dropped.
+	WALMaxBatches int `toml:"wal-max-batches"`
+
+	Endpoints []RemoteWriteEndpoint `toml:"endpoints"`
+}
+
+// RemoteWriteEndpoint is a single remote-write target and its auth.
+type RemoteWriteEndpoint struct {
+	URL          string `toml:"url"`
+	TenantHeader string `toml:"tenant-header"`
+
+	BearerToken       string `toml:"bearer-token"`
+	BasicAuthUser     string `toml:"basic-auth-user"`
+	BasicAuthPassword string `toml:"basic-auth-password"`
+
+	MaxRetries int `toml:"max-retries"`
+}
@@ -0,0 +1,12 @@
+package constants
+
+// PriceSource tags a PriceInfo with where its value came from, exposed
+// as the "source" label on the price metric.
+type PriceSource string
+
+const (
+	PriceSourceBand      PriceSource = "band"
+	PriceSourcePyth      PriceSource = "pyth"
+	PriceSourceChainlink PriceSource = "chainlink"
+	PriceSourceCoingecko PriceSource = "coingecko"
+)
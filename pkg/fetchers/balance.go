@@ -0,0 +1,101 @@
+package fetchers
+
+import (
+	"context"
+	"log/slog"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type BalanceFetcher struct {
+	Logger *slog.Logger
+	Chains []*config.Chain
+	RPCs   map[string]*tendermint.RPCWithConsumers
+	Tracer trace.Tracer
+}
+
+type BalanceData struct {
+	Balances map[string]map[string]float64
+}
+
+func NewBalanceFetcher(
+	logger *slog.Logger,
+	chains []*config.Chain,
+	rpcs map[string]*tendermint.RPCWithConsumers,
+	tracer trace.Tracer,
+) *BalanceFetcher {
+	return &BalanceFetcher{
+		Logger: logger.With("component", "balance_fetcher"),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracer,
+	}
+}
+
+func (q *BalanceFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (q *BalanceFetcher) Fetch(
+	ctx context.Context,
+	data ...interface{},
+) (interface{}, []*types.QueryInfo) {
+	var queryInfos []*types.QueryInfo
+
+	allBalances := map[string]map[string]float64{}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	for _, chain := range q.Chains {
+		mutex.Lock()
+		allBalances[chain.Name] = map[string]float64{}
+		mutex.Unlock()
+
+		rpc, _ := q.RPCs[chain.Name]
+
+		for _, validator := range chain.Validators {
+			wg.Add(1)
+
+			go func(validator string, rpc *tendermint.RPC, chain *config.Chain) {
+				defer wg.Done()
+
+				balance, query, err := rpc.GetBalance(validator, ctx)
+
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				if query != nil {
+					queryInfos = append(queryInfos, query)
+				}
+
+				if err != nil {
+					q.Logger.Error(
+						"Error querying validator operator balance",
+						"error", err,
+						"chain", chain.Name,
+						"address", validator,
+					)
+					return
+				}
+
+				if balance != nil {
+					allBalances[chain.Name][validator] = balance.Amount
+				}
+			}(validator.Address, rpc.RPC, chain)
+		}
+	}
+
+	wg.Wait()
+
+	return BalanceData{Balances: allBalances}, queryInfos
+}
+
+func (q *BalanceFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameBalance
+}
@@ -0,0 +1,120 @@
+package fetchers
+
+import (
+	"context"
+	"log/slog"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ConsumerCommissionFetcher struct {
+	Logger    *slog.Logger
+	Chains    []*config.Chain
+	RPCs      map[string]*tendermint.RPCWithConsumers
+	Tracer    trace.Tracer
+	Scheduler *tendermint.Scheduler
+}
+
+type ConsumerCommissionData struct {
+	Rates map[string]map[string]float64
+}
+
+func NewConsumerCommissionFetcher(
+	logger *slog.Logger,
+	chains []*config.Chain,
+	rpcs map[string]*tendermint.RPCWithConsumers,
+	tracer trace.Tracer,
+	scheduler *tendermint.Scheduler,
+) *ConsumerCommissionFetcher {
+	return &ConsumerCommissionFetcher{
+		Logger:    logger.With("component", "consumer_commission_fetcher"),
+		Chains:    chains,
+		RPCs:      rpcs,
+		Tracer:    tracer,
+		Scheduler: scheduler,
+	}
+}
+
+func (q *ConsumerCommissionFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (q *ConsumerCommissionFetcher) Fetch(
+	ctx context.Context,
+	data ...interface{},
+) (interface{}, []*types.QueryInfo) {
+	var queryInfos []*types.QueryInfo
+
+	allRates := map[string]map[string]float64{}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	for _, chain := range q.Chains {
+		rpc, _ := q.RPCs[chain.Name]
+
+		for _, consumerChain := range chain.ConsumerChains {
+			mutex.Lock()
+			if allRates[consumerChain.Name] == nil {
+				allRates[consumerChain.Name] = map[string]float64{}
+			}
+			mutex.Unlock()
+
+			consumerRPC, ok := rpc.Consumers[consumerChain.Name]
+			if !ok {
+				continue
+			}
+
+			for _, validator := range chain.Validators {
+				wg.Add(1)
+
+				go func(validator string, consumerRPC *tendermint.RPC, consumerChain *config.Chain) {
+					defer wg.Done()
+
+					release, limiterErr := q.Scheduler.LimiterFor(consumerChain.Name, consumerRPC.URL).Acquire(ctx)
+					if limiterErr != nil {
+						q.Logger.Error("Error waiting for rate limiter", "error", limiterErr, "chain", consumerChain.Name)
+						return
+					}
+					defer release()
+
+					commission, query, err := consumerRPC.GetValidatorCommission(validator, ctx)
+
+					mutex.Lock()
+					defer mutex.Unlock()
+
+					if query != nil {
+						queryInfos = append(queryInfos, query)
+					}
+
+					if err != nil {
+						q.Logger.Error(
+							"Error querying consumer chain validator commission",
+							"error", err,
+							"chain", consumerChain.Name,
+							"address", validator,
+						)
+						return
+					}
+
+					if commission != nil {
+						allRates[consumerChain.Name][validator] = commission.Rate
+					}
+				}(validator.Address, consumerRPC, consumerChain)
+			}
+		}
+	}
+
+	wg.Wait()
+
+	return ConsumerCommissionData{Rates: allRates}, queryInfos
+}
+
+func (q *ConsumerCommissionFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameConsumerCommission
+}
@@ -0,0 +1,103 @@
+package fetchers
+
+import (
+	"context"
+	"log/slog"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ConsumerInfoFetcher struct {
+	Logger    *slog.Logger
+	Chains    []*config.Chain
+	RPCs      map[string]*tendermint.RPCWithConsumers
+	Tracer    trace.Tracer
+	Scheduler *tendermint.Scheduler
+}
+
+type ConsumerInfoData struct {
+	Info map[string]*types.ConsumerInfoResponse
+}
+
+func NewConsumerInfoFetcher(
+	logger *slog.Logger,
+	chains []*config.Chain,
+	rpcs map[string]*tendermint.RPCWithConsumers,
+	tracer trace.Tracer,
+	scheduler *tendermint.Scheduler,
+) *ConsumerInfoFetcher {
+	return &ConsumerInfoFetcher{
+		Logger:    logger.With("component", "consumer_info_fetcher"),
+		Chains:    chains,
+		RPCs:      rpcs,
+		Tracer:    tracer,
+		Scheduler: scheduler,
+	}
+}
+
+func (q *ConsumerInfoFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (q *ConsumerInfoFetcher) Fetch(
+	ctx context.Context,
+	data ...interface{},
+) (interface{}, []*types.QueryInfo) {
+	var queryInfos []*types.QueryInfo
+
+	allInfo := map[string]*types.ConsumerInfoResponse{}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	for _, chain := range q.Chains {
+		rpc, ok := q.RPCs[chain.Name]
+		if !ok {
+			continue
+		}
+
+		wg.Add(1)
+
+		go func(chain *config.Chain, rpc *tendermint.RPCWithConsumers) {
+			defer wg.Done()
+
+			release, limiterErr := q.Scheduler.LimiterFor(chain.Name, rpc.RPC.URL).Acquire(ctx)
+			if limiterErr != nil {
+				q.Logger.Error("Error waiting for rate limiter", "error", limiterErr, "chain", chain.Name)
+				return
+			}
+			defer release()
+
+			info, query, err := rpc.RPC.GetConsumerInfo(ctx)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if query != nil {
+				queryInfos = append(queryInfos, query)
+			}
+
+			if err != nil {
+				q.Logger.Error("Error querying consumer chain info", "error", err, "chain", chain.Name)
+				return
+			}
+
+			if info != nil {
+				allInfo[chain.Name] = info
+			}
+		}(chain, rpc)
+	}
+
+	wg.Wait()
+
+	return ConsumerInfoData{Info: allInfo}, queryInfos
+}
+
+func (q *ConsumerInfoFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameConsumerInfo
+}
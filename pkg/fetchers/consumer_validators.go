@@ -0,0 +1,105 @@
+package fetchers
+
+import (
+	"context"
+	"log/slog"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ConsumerValidatorsFetcher struct {
+	Logger    *slog.Logger
+	Chains    []*config.Chain
+	RPCs      map[string]*tendermint.RPCWithConsumers
+	Tracer    trace.Tracer
+	Scheduler *tendermint.Scheduler
+}
+
+type ConsumerValidatorsData struct {
+	Validators map[string][]*types.Validator
+}
+
+func NewConsumerValidatorsFetcher(
+	logger *slog.Logger,
+	chains []*config.Chain,
+	rpcs map[string]*tendermint.RPCWithConsumers,
+	tracer trace.Tracer,
+	scheduler *tendermint.Scheduler,
+) *ConsumerValidatorsFetcher {
+	return &ConsumerValidatorsFetcher{
+		Logger:    logger.With("component", "consumer_validators_fetcher"),
+		Chains:    chains,
+		RPCs:      rpcs,
+		Tracer:    tracer,
+		Scheduler: scheduler,
+	}
+}
+
+func (q *ConsumerValidatorsFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (q *ConsumerValidatorsFetcher) Fetch(
+	ctx context.Context,
+	data ...interface{},
+) (interface{}, []*types.QueryInfo) {
+	var queryInfos []*types.QueryInfo
+
+	allValidators := map[string][]*types.Validator{}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	for _, chain := range q.Chains {
+		rpc, _ := q.RPCs[chain.Name]
+
+		for _, consumerChain := range chain.ConsumerChains {
+			wg.Add(1)
+
+			go func(consumerChain *config.Chain, rpc *tendermint.RPCWithConsumers) {
+				defer wg.Done()
+
+				consumerRPC, ok := rpc.Consumers[consumerChain.Name]
+				if !ok {
+					return
+				}
+
+				release, limiterErr := q.Scheduler.LimiterFor(consumerChain.Name, consumerRPC.URL).Acquire(ctx)
+				if limiterErr != nil {
+					q.Logger.Error("Error waiting for rate limiter", "error", limiterErr, "chain", consumerChain.Name)
+					return
+				}
+				defer release()
+
+				validators, query, err := consumerRPC.GetValidators(ctx)
+
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				if query != nil {
+					queryInfos = append(queryInfos, query)
+				}
+
+				if err != nil {
+					q.Logger.Error("Error querying consumer chain validators", "error", err, "chain", consumerChain.Name)
+					return
+				}
+
+				allValidators[consumerChain.Name] = validators
+			}(consumerChain, rpc)
+		}
+	}
+
+	wg.Wait()
+
+	return ConsumerValidatorsData{Validators: allValidators}, queryInfos
+}
+
+func (q *ConsumerValidatorsFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameConsumerValidators
+}
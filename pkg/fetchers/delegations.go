@@ -0,0 +1,112 @@
+package fetchers
+
+import (
+	"context"
+	"log/slog"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type DelegationsFetcher struct {
+	Logger    *slog.Logger
+	Chains    []*config.Chain
+	RPCs      map[string]*tendermint.RPCWithConsumers
+	Tracer    trace.Tracer
+	Scheduler *tendermint.Scheduler
+}
+
+type DelegationsData struct {
+	Delegations map[string]map[string]uint64
+}
+
+func NewDelegationsFetcher(
+	logger *slog.Logger,
+	chains []*config.Chain,
+	rpcs map[string]*tendermint.RPCWithConsumers,
+	tracer trace.Tracer,
+	scheduler *tendermint.Scheduler,
+) *DelegationsFetcher {
+	return &DelegationsFetcher{
+		Logger:    logger.With("component", "delegations_fetcher"),
+		Chains:    chains,
+		RPCs:      rpcs,
+		Tracer:    tracer,
+		Scheduler: scheduler,
+	}
+}
+
+func (q *DelegationsFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (q *DelegationsFetcher) Fetch(
+	ctx context.Context,
+	data ...interface{},
+) (interface{}, []*types.QueryInfo) {
+	var queryInfos []*types.QueryInfo
+
+	allDelegations := map[string]map[string]uint64{}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	for _, chain := range q.Chains {
+		mutex.Lock()
+		allDelegations[chain.Name] = map[string]uint64{}
+		mutex.Unlock()
+
+		rpc, _ := q.RPCs[chain.Name]
+
+		for _, validator := range chain.Validators {
+			wg.Add(1)
+			go func(validator string, rpc *tendermint.RPC, chain *config.Chain) {
+				defer wg.Done()
+
+				release, limiterErr := q.Scheduler.LimiterFor(chain.Name, rpc.URL).Acquire(ctx)
+				if limiterErr != nil {
+					q.Logger.Error("Error waiting for rate limiter", "error", limiterErr, "chain", chain.Name)
+					return
+				}
+				defer release()
+
+				delegationsResponse, query, err := rpc.GetDelegationsCount(validator, ctx)
+
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				if query != nil {
+					queryInfos = append(queryInfos, query)
+				}
+
+				if err != nil {
+					q.Logger.Error(
+						"Error querying validator delegations count",
+						"error", err,
+						"chain", chain.Name,
+						"address", validator,
+					)
+					return
+				}
+
+				if delegationsResponse == nil {
+					return
+				}
+
+				allDelegations[chain.Name][validator] = delegationsResponse.Pagination.Total
+			}(validator.Address, rpc.RPC, chain)
+		}
+	}
+
+	wg.Wait()
+
+	return DelegationsData{Delegations: allDelegations}, queryInfos
+}
+
+func (q *DelegationsFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameDelegations
+}
@@ -0,0 +1,90 @@
+package fetchers
+
+import (
+	"context"
+	"log/slog"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type InflationFetcher struct {
+	Logger *slog.Logger
+	Chains []*config.Chain
+	RPCs   map[string]*tendermint.RPCWithConsumers
+	Tracer trace.Tracer
+}
+
+type InflationData struct {
+	Inflation map[string]float64
+}
+
+func NewInflationFetcher(
+	logger *slog.Logger,
+	chains []*config.Chain,
+	rpcs map[string]*tendermint.RPCWithConsumers,
+	tracer trace.Tracer,
+) *InflationFetcher {
+	return &InflationFetcher{
+		Logger: logger.With("component", "inflation_fetcher"),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracer,
+	}
+}
+
+func (q *InflationFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (q *InflationFetcher) Fetch(
+	ctx context.Context,
+	data ...interface{},
+) (interface{}, []*types.QueryInfo) {
+	var queryInfos []*types.QueryInfo
+
+	allInflation := map[string]float64{}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	for _, chain := range q.Chains {
+		rpc, _ := q.RPCs[chain.Name]
+
+		wg.Add(1)
+
+		go func(chain *config.Chain, rpc *tendermint.RPC) {
+			defer wg.Done()
+
+			inflation, query, err := rpc.GetInflation(ctx)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if query != nil {
+				queryInfos = append(queryInfos, query)
+			}
+
+			if err != nil {
+				q.Logger.Error("Error querying inflation", "error", err, "chain", chain.Name)
+				return
+			}
+
+			if inflation != nil {
+				allInflation[chain.Name] = inflation.Inflation
+			}
+		}(chain, rpc.RPC)
+	}
+
+	wg.Wait()
+
+	return InflationData{Inflation: allInflation}, queryInfos
+}
+
+func (q *InflationFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameInflation
+}
@@ -0,0 +1,90 @@
+package fetchers
+
+import (
+	"context"
+	"log/slog"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type NodeInfoFetcher struct {
+	Logger *slog.Logger
+	Chains []*config.Chain
+	RPCs   map[string]*tendermint.RPCWithConsumers
+	Tracer trace.Tracer
+}
+
+type NodeInfoData struct {
+	Info map[string]*types.NodeInfoResponse
+}
+
+func NewNodeInfoFetcher(
+	logger *slog.Logger,
+	chains []*config.Chain,
+	rpcs map[string]*tendermint.RPCWithConsumers,
+	tracer trace.Tracer,
+) *NodeInfoFetcher {
+	return &NodeInfoFetcher{
+		Logger: logger.With("component", "node_info_fetcher"),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracer,
+	}
+}
+
+func (q *NodeInfoFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (q *NodeInfoFetcher) Fetch(
+	ctx context.Context,
+	data ...interface{},
+) (interface{}, []*types.QueryInfo) {
+	var queryInfos []*types.QueryInfo
+
+	allInfo := map[string]*types.NodeInfoResponse{}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	for _, chain := range q.Chains {
+		rpc, _ := q.RPCs[chain.Name]
+
+		wg.Add(1)
+
+		go func(chain *config.Chain, rpc *tendermint.RPC) {
+			defer wg.Done()
+
+			info, query, err := rpc.GetNodeInfo(ctx)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if query != nil {
+				queryInfos = append(queryInfos, query)
+			}
+
+			if err != nil {
+				q.Logger.Error("Error querying node info", "error", err, "chain", chain.Name)
+				return
+			}
+
+			if info != nil {
+				allInfo[chain.Name] = info
+			}
+		}(chain, rpc.RPC)
+	}
+
+	wg.Wait()
+
+	return NodeInfoData{Info: allInfo}, queryInfos
+}
+
+func (q *NodeInfoFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameNodeInfo
+}
@@ -0,0 +1,88 @@
+package oracle
+
+import (
+	"context"
+	"log/slog"
+	"main/pkg/config"
+	"main/pkg/constants"
+	fetchersPkg "main/pkg/fetchers"
+	"main/pkg/tendermint"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Aggregator resolves each configured OracleFeed through its Provider,
+// falling back to whatever fiat-API price PriceFetcher already collected
+// for that denom when the on-chain call fails or its data is stale, and
+// exposes an oracle-vs-market divergence gauge whenever both are
+// available.
+type Aggregator struct {
+	Logger     *slog.Logger
+	Providers  map[string]Provider
+	Divergence *prometheus.GaugeVec
+}
+
+func NewAggregator(
+	logger *slog.Logger,
+	rpcs map[string]*tendermint.RPCWithConsumers,
+	scheduler *tendermint.Scheduler,
+) *Aggregator {
+	return &Aggregator{
+		Logger: logger.With("component", "oracle_aggregator"),
+		Providers: map[string]Provider{
+			"band":      NewBandProvider(rpcs, scheduler),
+			"pyth":      NewPythProvider(rpcs, scheduler),
+			"chainlink": NewChainlinkProvider(scheduler),
+		},
+		Divergence: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "price_oracle_divergence_ratio",
+				Help: "Relative difference between an on-chain oracle price and the fiat-API price for the same denom",
+			},
+			[]string{"chain", "denom", "source"},
+		),
+	}
+}
+
+// Resolve returns the best available price for a feed: the on-chain
+// oracle reading if the provider succeeds and isn't stale, otherwise
+// marketPrice unchanged. When both are available it also records the
+// divergence between them.
+func (a *Aggregator) Resolve(
+	ctx context.Context,
+	chain *config.Chain,
+	feed config.OracleFeed,
+	marketPrice *fetchersPkg.PriceInfo,
+) *fetchersPkg.PriceInfo {
+	provider, ok := a.Providers[feed.Type]
+	if !ok {
+		a.Logger.Warn("Unknown oracle provider type, skipping feed", "type", feed.Type, "chain", chain.Name)
+		return marketPrice
+	}
+
+	oraclePrice, err := provider.FetchPrice(ctx, chain, feed)
+	if err != nil {
+		a.Logger.Warn(
+			"Could not fetch on-chain oracle price, falling back to fiat API source",
+			"error", err,
+			"chain", chain.Name,
+			"symbol", feed.Symbol,
+		)
+		return marketPrice
+	}
+
+	if marketPrice != nil && marketPrice.Value > 0 {
+		divergence := (oraclePrice.Value - marketPrice.Value) / marketPrice.Value
+		a.Divergence.With(prometheus.Labels{
+			"chain":  chain.Name,
+			"denom":  feed.Symbol,
+			"source": string(oraclePrice.Source),
+		}).Set(divergence)
+	}
+
+	return oraclePrice
+}
+
+func (a *Aggregator) Metrics() []prometheus.Collector {
+	return []prometheus.Collector{a.Divergence}
+}
@@ -0,0 +1,86 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"main/pkg/config"
+	fetchersPkg "main/pkg/fetchers"
+	"main/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type fakeProvider struct {
+	price *fetchersPkg.PriceInfo
+	err   error
+}
+
+func (p *fakeProvider) FetchPrice(
+	ctx context.Context,
+	chain *config.Chain,
+	feed config.OracleFeed,
+) (*fetchersPkg.PriceInfo, error) {
+	return p.price, p.err
+}
+
+func newTestAggregator(provider Provider) *Aggregator {
+	return &Aggregator{
+		Logger:    logger.GetDefaultLogger(),
+		Providers: map[string]Provider{"fake": provider},
+		Divergence: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{Name: "test_divergence"},
+			[]string{"chain", "denom", "source"},
+		),
+	}
+}
+
+func TestResolveFallsBackToMarketPriceOnProviderError(t *testing.T) {
+	aggregator := newTestAggregator(&fakeProvider{err: errors.New("rpc unavailable")})
+	chain := &config.Chain{Name: "test-chain"}
+	feed := config.OracleFeed{Type: "fake", Symbol: "atom"}
+	market := &fetchersPkg.PriceInfo{Value: 10, BaseCurrency: "USD"}
+
+	resolved := aggregator.Resolve(context.Background(), chain, feed, market)
+	if resolved != market {
+		t.Fatalf("expected fallback to the market price on provider error, got %+v", resolved)
+	}
+}
+
+func TestResolveReturnsMarketPriceForUnknownProviderType(t *testing.T) {
+	aggregator := newTestAggregator(&fakeProvider{})
+	chain := &config.Chain{Name: "test-chain"}
+	feed := config.OracleFeed{Type: "unknown", Symbol: "atom"}
+	market := &fetchersPkg.PriceInfo{Value: 10, BaseCurrency: "USD"}
+
+	resolved := aggregator.Resolve(context.Background(), chain, feed, market)
+	if resolved != market {
+		t.Fatalf("expected the market price for an unconfigured provider type, got %+v", resolved)
+	}
+}
+
+func TestResolvePrefersOracleAndRecordsDivergence(t *testing.T) {
+	oraclePrice := &fetchersPkg.PriceInfo{Value: 11, BaseCurrency: "USD", UpdatedAt: time.Now()}
+	aggregator := newTestAggregator(&fakeProvider{price: oraclePrice})
+	chain := &config.Chain{Name: "test-chain"}
+	feed := config.OracleFeed{Type: "fake", Symbol: "atom"}
+	market := &fetchersPkg.PriceInfo{Value: 10, BaseCurrency: "USD"}
+
+	resolved := aggregator.Resolve(context.Background(), chain, feed, market)
+	if resolved != oraclePrice {
+		t.Fatalf("expected the on-chain oracle price to win, got %+v", resolved)
+	}
+
+	gauge := aggregator.Divergence.With(prometheus.Labels{
+		"chain":  chain.Name,
+		"denom":  feed.Symbol,
+		"source": string(oraclePrice.Source),
+	})
+
+	expected := (oraclePrice.Value - market.Value) / market.Value
+	if got := testutil.ToFloat64(gauge); got != expected {
+		t.Fatalf("expected divergence %v, got %v", expected, got)
+	}
+}
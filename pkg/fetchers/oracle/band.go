@@ -0,0 +1,56 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"main/pkg/config"
+	"main/pkg/constants"
+	fetchersPkg "main/pkg/fetchers"
+	"main/pkg/tendermint"
+	"time"
+)
+
+// BandProvider reads the latest price straight from a chain's own Band
+// oracle module - the module Band-integrated chains expose for on-chain
+// consumers, so no external HTTP call is needed.
+type BandProvider struct {
+	RPCs      map[string]*tendermint.RPCWithConsumers
+	Scheduler *tendermint.Scheduler
+}
+
+func NewBandProvider(rpcs map[string]*tendermint.RPCWithConsumers, scheduler *tendermint.Scheduler) *BandProvider {
+	return &BandProvider{RPCs: rpcs, Scheduler: scheduler}
+}
+
+func (p *BandProvider) FetchPrice(
+	ctx context.Context,
+	chain *config.Chain,
+	feed config.OracleFeed,
+) (*fetchersPkg.PriceInfo, error) {
+	rpc, ok := p.RPCs[chain.Name]
+	if !ok {
+		return nil, fmt.Errorf("no RPC configured for chain %s", chain.Name)
+	}
+
+	release, limiterErr := p.Scheduler.LimiterFor(chain.Name, rpc.RPC.URL).Acquire(ctx)
+	if limiterErr != nil {
+		return nil, fmt.Errorf("rate limiter: %w", limiterErr)
+	}
+	defer release()
+
+	response, _, err := rpc.RPC.GetBandOraclePrice(ctx, feed.Symbol)
+	if err != nil {
+		return nil, fmt.Errorf("could not query Band oracle price for %s on %s: %w", feed.Symbol, chain.Name, err)
+	}
+
+	if response.ResolveTime.Add(feed.MaxAge).Before(time.Now()) {
+		return nil, fmt.Errorf("band oracle price for %s on %s is stale (resolved %s)", feed.Symbol, chain.Name, response.ResolveTime)
+	}
+
+	return &fetchersPkg.PriceInfo{
+		Value:        response.Price,
+		Source:       constants.PriceSourceBand,
+		BaseCurrency: "USD",
+		UpdatedAt:    response.ResolveTime,
+	}, nil
+}
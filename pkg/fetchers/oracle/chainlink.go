@@ -0,0 +1,177 @@
+package oracle
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"main/pkg/config"
+	"main/pkg/constants"
+	fetchersPkg "main/pkg/fetchers"
+	"main/pkg/tendermint"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// latestRoundDataSelector and decimalsSelector are the 4-byte Solidity
+// function selectors for AggregatorV3Interface.latestRoundData() and
+// .decimals(), the two calls needed to turn a raw Chainlink feed answer
+// into a display price.
+const (
+	latestRoundDataSelector = "0xfeaf968c"
+	decimalsSelector        = "0x313ce567"
+)
+
+// ChainlinkProvider reads a Chainlink-style AggregatorV3Interface feed
+// over the EVM JSON-RPC exposed by EVM-compatible Cosmos chains (eg.
+// Evmos, Injective), bypassing the Cosmos RPC entirely.
+type ChainlinkProvider struct {
+	Client    *http.Client
+	Scheduler *tendermint.Scheduler
+}
+
+func NewChainlinkProvider(scheduler *tendermint.Scheduler) *ChainlinkProvider {
+	return &ChainlinkProvider{
+		Client:    &http.Client{Timeout: 10 * time.Second},
+		Scheduler: scheduler,
+	}
+}
+
+func (p *ChainlinkProvider) FetchPrice(
+	ctx context.Context,
+	chain *config.Chain,
+	feed config.OracleFeed,
+) (*fetchersPkg.PriceInfo, error) {
+	decimals, err := p.ethCallUint8(ctx, chain, feed.RPCEndpoint, feed.ContractAddr, decimalsSelector)
+	if err != nil {
+		return nil, fmt.Errorf("could not read decimals for Chainlink feed %s: %w", feed.ContractAddr, err)
+	}
+
+	data, err := p.ethCall(ctx, chain, feed.RPCEndpoint, feed.ContractAddr, latestRoundDataSelector)
+	if err != nil {
+		return nil, fmt.Errorf("could not call latestRoundData on Chainlink feed %s: %w", feed.ContractAddr, err)
+	}
+
+	answer, updatedAt, err := decodeLatestRoundData(data)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode latestRoundData for feed %s: %w", feed.ContractAddr, err)
+	}
+
+	if time.Since(updatedAt) > feed.MaxAge {
+		return nil, fmt.Errorf("chainlink feed %s is stale (updated %s)", feed.ContractAddr, updatedAt)
+	}
+
+	scale := new(big.Float).SetFloat64(1)
+	if decimals > 0 {
+		scale.SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	}
+
+	value, _ := new(big.Float).Quo(new(big.Float).SetInt(answer), scale).Float64()
+
+	return &fetchersPkg.PriceInfo{
+		Value:        value,
+		Source:       constants.PriceSourceChainlink,
+		BaseCurrency: "USD",
+		UpdatedAt:    updatedAt,
+	}, nil
+}
+
+type jsonRPCRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      int           `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type jsonRPCResponse struct {
+	Result string `json:"result"`
+	Error  *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (p *ChainlinkProvider) ethCall(
+	ctx context.Context,
+	chain *config.Chain,
+	rpcEndpoint, contractAddr, selector string,
+) (string, error) {
+	release, limiterErr := p.Scheduler.LimiterFor(chain.Name, rpcEndpoint).Acquire(ctx)
+	if limiterErr != nil {
+		return "", fmt.Errorf("rate limiter: %w", limiterErr)
+	}
+	defer release()
+
+	payload := jsonRPCRequest{
+		JSONRPC: "2.0",
+		ID:      1,
+		Method:  "eth_call",
+		Params: []interface{}{
+			map[string]string{"to": contractAddr, "data": selector},
+			"latest",
+		},
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, rpcEndpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := p.Client.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	var decoded jsonRPCResponse
+	if err := json.NewDecoder(response.Body).Decode(&decoded); err != nil {
+		return "", err
+	}
+
+	if decoded.Error != nil {
+		return "", fmt.Errorf("eth_call error: %s", decoded.Error.Message)
+	}
+
+	return decoded.Result, nil
+}
+
+func (p *ChainlinkProvider) ethCallUint8(
+	ctx context.Context,
+	chain *config.Chain,
+	rpcEndpoint, contractAddr, selector string,
+) (uint8, error) {
+	result, err := p.ethCall(ctx, chain, rpcEndpoint, contractAddr, selector)
+	if err != nil {
+		return 0, err
+	}
+
+	raw, err := hex.DecodeString(strings.TrimPrefix(result, "0x"))
+	if err != nil || len(raw) == 0 {
+		return 0, fmt.Errorf("unexpected eth_call result: %s", result)
+	}
+
+	return raw[len(raw)-1], nil
+}
+
+// decodeLatestRoundData decodes the ABI-encoded tuple
+// (uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+// that latestRoundData() returns, taking the second and fourth words.
+func decodeLatestRoundData(result string) (*big.Int, time.Time, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(result, "0x"))
+	if err != nil || len(raw) < 5*32 {
+		return nil, time.Time{}, fmt.Errorf("unexpected eth_call result: %s", result)
+	}
+
+	answer := new(big.Int).SetBytes(raw[32:64])
+	updatedAt := new(big.Int).SetBytes(raw[96:128])
+
+	return answer, time.Unix(updatedAt.Int64(), 0), nil
+}
@@ -0,0 +1,15 @@
+package oracle
+
+import (
+	"context"
+	"main/pkg/config"
+	fetchersPkg "main/pkg/fetchers"
+)
+
+// Provider queries a single on-chain oracle for one configured feed and
+// returns it in the same shape the existing fiat-API price sources
+// already produce, so PriceGenerator doesn't need to know where a price
+// came from.
+type Provider interface {
+	FetchPrice(ctx context.Context, chain *config.Chain, feed config.OracleFeed) (*fetchersPkg.PriceInfo, error)
+}
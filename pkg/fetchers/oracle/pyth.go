@@ -0,0 +1,56 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"main/pkg/config"
+	"main/pkg/constants"
+	fetchersPkg "main/pkg/fetchers"
+	"main/pkg/tendermint"
+	"time"
+)
+
+// PythProvider reads a Pyth price account through the Wormhole/Pyth
+// receiver contract deployed on chains that host it, via the same
+// contract-query path the rest of the exporter uses for CosmWasm state.
+type PythProvider struct {
+	RPCs      map[string]*tendermint.RPCWithConsumers
+	Scheduler *tendermint.Scheduler
+}
+
+func NewPythProvider(rpcs map[string]*tendermint.RPCWithConsumers, scheduler *tendermint.Scheduler) *PythProvider {
+	return &PythProvider{RPCs: rpcs, Scheduler: scheduler}
+}
+
+func (p *PythProvider) FetchPrice(
+	ctx context.Context,
+	chain *config.Chain,
+	feed config.OracleFeed,
+) (*fetchersPkg.PriceInfo, error) {
+	rpc, ok := p.RPCs[chain.Name]
+	if !ok {
+		return nil, fmt.Errorf("no RPC configured for chain %s", chain.Name)
+	}
+
+	release, limiterErr := p.Scheduler.LimiterFor(chain.Name, rpc.RPC.URL).Acquire(ctx)
+	if limiterErr != nil {
+		return nil, fmt.Errorf("rate limiter: %w", limiterErr)
+	}
+	defer release()
+
+	response, _, err := rpc.RPC.GetPythPrice(ctx, feed.ContractAddr, feed.Symbol)
+	if err != nil {
+		return nil, fmt.Errorf("could not query Pyth price for %s on %s: %w", feed.Symbol, chain.Name, err)
+	}
+
+	if response.PublishTime.Add(feed.MaxAge).Before(time.Now()) {
+		return nil, fmt.Errorf("pyth price for %s on %s is stale (published %s)", feed.Symbol, chain.Name, response.PublishTime)
+	}
+
+	return &fetchersPkg.PriceInfo{
+		Value:        response.Price,
+		Source:       constants.PriceSourcePyth,
+		BaseCurrency: "USD",
+		UpdatedAt:    response.PublishTime,
+	}, nil
+}
@@ -0,0 +1,150 @@
+package fetchers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"main/pkg/config"
+	"main/pkg/constants"
+	oraclePkg "main/pkg/fetchers/oracle"
+	"main/pkg/types"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// PriceInfo is a single resolved price point for a chain's denom, tagged
+// with where it came from so PriceGenerator can expose both the value
+// and its source as labels.
+type PriceInfo struct {
+	Value        float64
+	Source       constants.PriceSource
+	BaseCurrency string
+	UpdatedAt    time.Time
+}
+
+type PriceData struct {
+	Prices map[string]map[string]*PriceInfo
+}
+
+const coingeckoSimplePriceURL = "https://api.coingecko.com/api/v3/simple/price"
+
+// PriceFetcher collects a market price for every chain's configured
+// OracleFeed symbol and hands it to Oracle, which resolves it through
+// the on-chain Band/Pyth/Chainlink provider matching the feed's type and
+// falls back to the market price whenever that on-chain source is
+// missing or stale.
+type PriceFetcher struct {
+	Logger *slog.Logger
+	Chains []*config.Chain
+	Tracer trace.Tracer
+	Oracle *oraclePkg.Aggregator
+	Client *http.Client
+}
+
+func NewPriceFetcher(
+	logger *slog.Logger,
+	appConfig *config.Config,
+	tracer trace.Tracer,
+	oracle *oraclePkg.Aggregator,
+) *PriceFetcher {
+	return &PriceFetcher{
+		Logger: logger.With("component", "price_fetcher"),
+		Chains: appConfig.Chains,
+		Tracer: tracer,
+		Oracle: oracle,
+		Client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *PriceFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (p *PriceFetcher) Fetch(ctx context.Context, data ...interface{}) (interface{}, []*types.QueryInfo) {
+	var queryInfos []*types.QueryInfo
+
+	allPrices := map[string]map[string]*PriceInfo{}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	for _, chain := range p.Chains {
+		mutex.Lock()
+		allPrices[chain.Name] = map[string]*PriceInfo{}
+		mutex.Unlock()
+
+		for _, feed := range chain.OracleFeeds {
+			wg.Add(1)
+
+			go func(chain *config.Chain, feed config.OracleFeed) {
+				defer wg.Done()
+
+				marketPrice, err := p.fetchMarketPrice(ctx, feed.Symbol)
+				if err != nil {
+					p.Logger.Warn(
+						"Error querying market price, oracle resolution will have no fallback",
+						"error", err,
+						"chain", chain.Name,
+						"symbol", feed.Symbol,
+					)
+				}
+
+				resolved := p.Oracle.Resolve(ctx, chain, feed, marketPrice)
+				if resolved == nil {
+					return
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				allPrices[chain.Name][feed.Symbol] = resolved
+			}(chain, feed)
+		}
+	}
+
+	wg.Wait()
+
+	return PriceData{Prices: allPrices}, queryInfos
+}
+
+func (p *PriceFetcher) Name() constants.FetcherName {
+	return constants.FetcherNamePrice
+}
+
+// fetchMarketPrice calls the fiat-API price source PriceGenerator has
+// always ultimately rendered, now also serving as the fallback Oracle
+// uses when a chain's on-chain provider is missing or stale.
+func (p *PriceFetcher) fetchMarketPrice(ctx context.Context, symbol string) (*PriceInfo, error) {
+	url := fmt.Sprintf("%s?ids=%s&vs_currencies=usd", coingeckoSimplePriceURL, symbol)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var decoded map[string]map[string]float64
+	if err := json.NewDecoder(response.Body).Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	usd, ok := decoded[symbol]["usd"]
+	if !ok {
+		return nil, fmt.Errorf("no market price returned for %s", symbol)
+	}
+
+	return &PriceInfo{
+		Value:        usd,
+		Source:       constants.PriceSourceCoingecko,
+		BaseCurrency: "USD",
+		UpdatedAt:    time.Now(),
+	}, nil
+}
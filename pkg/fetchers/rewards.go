@@ -0,0 +1,101 @@
+package fetchers
+
+import (
+	"context"
+	"log/slog"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type RewardsFetcher struct {
+	Logger *slog.Logger
+	Chains []*config.Chain
+	RPCs   map[string]*tendermint.RPCWithConsumers
+	Tracer trace.Tracer
+}
+
+type RewardsData struct {
+	Rewards map[string]map[string]float64
+}
+
+func NewRewardsFetcher(
+	logger *slog.Logger,
+	chains []*config.Chain,
+	rpcs map[string]*tendermint.RPCWithConsumers,
+	tracer trace.Tracer,
+) *RewardsFetcher {
+	return &RewardsFetcher{
+		Logger: logger.With("component", "rewards_fetcher"),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracer,
+	}
+}
+
+func (q *RewardsFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (q *RewardsFetcher) Fetch(
+	ctx context.Context,
+	data ...interface{},
+) (interface{}, []*types.QueryInfo) {
+	var queryInfos []*types.QueryInfo
+
+	allRewards := map[string]map[string]float64{}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	for _, chain := range q.Chains {
+		mutex.Lock()
+		allRewards[chain.Name] = map[string]float64{}
+		mutex.Unlock()
+
+		rpc, _ := q.RPCs[chain.Name]
+
+		for _, validator := range chain.Validators {
+			wg.Add(1)
+
+			go func(validator string, rpc *tendermint.RPC, chain *config.Chain) {
+				defer wg.Done()
+
+				rewards, query, err := rpc.GetValidatorRewards(validator, ctx)
+
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				if query != nil {
+					queryInfos = append(queryInfos, query)
+				}
+
+				if err != nil {
+					q.Logger.Error(
+						"Error querying validator rewards",
+						"error", err,
+						"chain", chain.Name,
+						"address", validator,
+					)
+					return
+				}
+
+				if rewards != nil {
+					allRewards[chain.Name][validator] = rewards.Amount
+				}
+			}(validator.Address, rpc.RPC, chain)
+		}
+	}
+
+	wg.Wait()
+
+	return RewardsData{Rewards: allRewards}, queryInfos
+}
+
+func (q *RewardsFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameRewards
+}
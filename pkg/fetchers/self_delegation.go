@@ -0,0 +1,101 @@
+package fetchers
+
+import (
+	"context"
+	"log/slog"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type SelfDelegationFetcher struct {
+	Logger *slog.Logger
+	Chains []*config.Chain
+	RPCs   map[string]*tendermint.RPCWithConsumers
+	Tracer trace.Tracer
+}
+
+type SelfDelegationData struct {
+	SelfDelegations map[string]map[string]float64
+}
+
+func NewSelfDelegationFetcher(
+	logger *slog.Logger,
+	chains []*config.Chain,
+	rpcs map[string]*tendermint.RPCWithConsumers,
+	tracer trace.Tracer,
+) *SelfDelegationFetcher {
+	return &SelfDelegationFetcher{
+		Logger: logger.With("component", "self_delegation_fetcher"),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracer,
+	}
+}
+
+func (q *SelfDelegationFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (q *SelfDelegationFetcher) Fetch(
+	ctx context.Context,
+	data ...interface{},
+) (interface{}, []*types.QueryInfo) {
+	var queryInfos []*types.QueryInfo
+
+	allSelfDelegations := map[string]map[string]float64{}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	for _, chain := range q.Chains {
+		mutex.Lock()
+		allSelfDelegations[chain.Name] = map[string]float64{}
+		mutex.Unlock()
+
+		rpc, _ := q.RPCs[chain.Name]
+
+		for _, validator := range chain.Validators {
+			wg.Add(1)
+
+			go func(validator string, rpc *tendermint.RPC, chain *config.Chain) {
+				defer wg.Done()
+
+				selfDelegation, query, err := rpc.GetSelfDelegation(validator, ctx)
+
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				if query != nil {
+					queryInfos = append(queryInfos, query)
+				}
+
+				if err != nil {
+					q.Logger.Error(
+						"Error querying validator self-delegation",
+						"error", err,
+						"chain", chain.Name,
+						"address", validator,
+					)
+					return
+				}
+
+				if selfDelegation != nil {
+					allSelfDelegations[chain.Name][validator] = selfDelegation.Amount
+				}
+			}(validator.Address, rpc.RPC, chain)
+		}
+	}
+
+	wg.Wait()
+
+	return SelfDelegationData{SelfDelegations: allSelfDelegations}, queryInfos
+}
+
+func (q *SelfDelegationFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameSelfDelegation
+}
@@ -0,0 +1,101 @@
+package fetchers
+
+import (
+	"context"
+	"log/slog"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type SigningInfoFetcher struct {
+	Logger *slog.Logger
+	Chains []*config.Chain
+	RPCs   map[string]*tendermint.RPCWithConsumers
+	Tracer trace.Tracer
+}
+
+type SigningInfoData struct {
+	Infos map[string]map[string]*types.SigningInfoResponse
+}
+
+func NewSigningInfoFetcher(
+	logger *slog.Logger,
+	chains []*config.Chain,
+	rpcs map[string]*tendermint.RPCWithConsumers,
+	tracer trace.Tracer,
+) *SigningInfoFetcher {
+	return &SigningInfoFetcher{
+		Logger: logger.With("component", "signing_info_fetcher"),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracer,
+	}
+}
+
+func (q *SigningInfoFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (q *SigningInfoFetcher) Fetch(
+	ctx context.Context,
+	data ...interface{},
+) (interface{}, []*types.QueryInfo) {
+	var queryInfos []*types.QueryInfo
+
+	allInfos := map[string]map[string]*types.SigningInfoResponse{}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	for _, chain := range q.Chains {
+		mutex.Lock()
+		allInfos[chain.Name] = map[string]*types.SigningInfoResponse{}
+		mutex.Unlock()
+
+		rpc, _ := q.RPCs[chain.Name]
+
+		for _, validator := range chain.Validators {
+			wg.Add(1)
+
+			go func(validator string, rpc *tendermint.RPC, chain *config.Chain) {
+				defer wg.Done()
+
+				info, query, err := rpc.GetSigningInfo(validator, ctx)
+
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				if query != nil {
+					queryInfos = append(queryInfos, query)
+				}
+
+				if err != nil {
+					q.Logger.Error(
+						"Error querying validator signing info",
+						"error", err,
+						"chain", chain.Name,
+						"address", validator,
+					)
+					return
+				}
+
+				if info != nil {
+					allInfos[chain.Name][validator] = info
+				}
+			}(validator.Address, rpc.RPC, chain)
+		}
+	}
+
+	wg.Wait()
+
+	return SigningInfoData{Infos: allInfos}, queryInfos
+}
+
+func (q *SigningInfoFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameSigningInfo
+}
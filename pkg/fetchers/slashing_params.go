@@ -0,0 +1,93 @@
+package fetchers
+
+import (
+	"context"
+	"log/slog"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type SlashingParamsFetcher struct {
+	Logger *slog.Logger
+	Chains []*config.Chain
+	RPCs   map[string]*tendermint.RPCWithConsumers
+	Tracer trace.Tracer
+}
+
+type SlashingParamsData struct {
+	Params map[string]*types.SlashingParamsResponse
+}
+
+func NewSlashingParamsFetcher(
+	logger *slog.Logger,
+	chains []*config.Chain,
+	rpcs map[string]*tendermint.RPCWithConsumers,
+	tracer trace.Tracer,
+) *SlashingParamsFetcher {
+	return &SlashingParamsFetcher{
+		Logger: logger.With("component", "slashing_params_fetcher"),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracer,
+	}
+}
+
+func (q *SlashingParamsFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (q *SlashingParamsFetcher) Fetch(
+	ctx context.Context,
+	data ...interface{},
+) (interface{}, []*types.QueryInfo) {
+	var queryInfos []*types.QueryInfo
+
+	allParams := map[string]*types.SlashingParamsResponse{}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	for _, chain := range q.Chains {
+		rpc, _ := q.RPCs[chain.Name]
+
+		wg.Add(1)
+
+		go func(chain *config.Chain, rpc *tendermint.RPC) {
+			defer wg.Done()
+
+			params, query, err := rpc.GetSlashingParams(ctx)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if query != nil {
+				queryInfos = append(queryInfos, query)
+			}
+
+			if err != nil {
+				q.Logger.Error("Error querying slashing params", "error", err, "chain", chain.Name)
+				return
+			}
+
+			if params != nil {
+				allParams[chain.Name] = params
+				for _, consumerChain := range chain.ConsumerChains {
+					allParams[consumerChain.Name] = params
+				}
+			}
+		}(chain, rpc.RPC)
+	}
+
+	wg.Wait()
+
+	return SlashingParamsData{Params: allParams}, queryInfos
+}
+
+func (q *SlashingParamsFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameSlashingParams
+}
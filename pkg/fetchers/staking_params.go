@@ -2,21 +2,22 @@ package fetchers
 
 import (
 	"context"
+	"log/slog"
 	"main/pkg/config"
 	"main/pkg/constants"
 	"main/pkg/tendermint"
 	"main/pkg/types"
 	"sync"
 
-	"github.com/rs/zerolog"
 	"go.opentelemetry.io/otel/trace"
 )
 
 type StakingParamsFetcher struct {
-	Logger zerolog.Logger
-	Chains []*config.Chain
-	RPCs   map[string]*tendermint.RPCWithConsumers
-	Tracer trace.Tracer
+	Logger    *slog.Logger
+	Chains    []*config.Chain
+	RPCs      map[string]*tendermint.RPCWithConsumers
+	Tracer    trace.Tracer
+	Scheduler *tendermint.Scheduler
 }
 
 type StakingParamsData struct {
@@ -24,16 +25,18 @@ type StakingParamsData struct {
 }
 
 func NewStakingParamsFetcher(
-	logger *zerolog.Logger,
+	logger *slog.Logger,
 	chains []*config.Chain,
 	rpcs map[string]*tendermint.RPCWithConsumers,
 	tracer trace.Tracer,
+	scheduler *tendermint.Scheduler,
 ) *StakingParamsFetcher {
 	return &StakingParamsFetcher{
-		Logger: logger.With().Str("component", "staking_params_fetcher").Logger(),
-		Chains: chains,
-		RPCs:   rpcs,
-		Tracer: tracer,
+		Logger:    logger.With("component", "staking_params_fetcher"),
+		Chains:    chains,
+		RPCs:      rpcs,
+		Tracer:    tracer,
+		Scheduler: scheduler,
 	}
 }
 
@@ -63,6 +66,13 @@ func (q *StakingParamsFetcher) Fetch(
 		go func(chain *config.Chain, rpc *tendermint.RPC) {
 			defer wg.Done()
 
+			release, limiterErr := q.Scheduler.LimiterFor(chain.Name, rpc.URL).Acquire(ctx)
+			if limiterErr != nil {
+				q.Logger.Error("Error waiting for rate limiter", "error", limiterErr, "chain", chain.Name)
+				return
+			}
+			defer release()
+
 			params, query, err := rpc.GetStakingParams(ctx)
 
 			mutex.Lock()
@@ -73,10 +83,7 @@ func (q *StakingParamsFetcher) Fetch(
 			}
 
 			if err != nil {
-				q.Logger.Error().
-					Err(err).
-					Str("chain", chain.Name).
-					Msg("Error querying staking params")
+				q.Logger.Error("Error querying staking params", "error", err, "chain", chain.Name)
 				return
 			}
 
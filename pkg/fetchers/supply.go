@@ -0,0 +1,90 @@
+package fetchers
+
+import (
+	"context"
+	"log/slog"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type SupplyFetcher struct {
+	Logger *slog.Logger
+	Chains []*config.Chain
+	RPCs   map[string]*tendermint.RPCWithConsumers
+	Tracer trace.Tracer
+}
+
+type SupplyData struct {
+	Supply map[string]float64
+}
+
+func NewSupplyFetcher(
+	logger *slog.Logger,
+	chains []*config.Chain,
+	rpcs map[string]*tendermint.RPCWithConsumers,
+	tracer trace.Tracer,
+) *SupplyFetcher {
+	return &SupplyFetcher{
+		Logger: logger.With("component", "supply_fetcher"),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracer,
+	}
+}
+
+func (q *SupplyFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (q *SupplyFetcher) Fetch(
+	ctx context.Context,
+	data ...interface{},
+) (interface{}, []*types.QueryInfo) {
+	var queryInfos []*types.QueryInfo
+
+	allSupply := map[string]float64{}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	for _, chain := range q.Chains {
+		rpc, _ := q.RPCs[chain.Name]
+
+		wg.Add(1)
+
+		go func(chain *config.Chain, rpc *tendermint.RPC) {
+			defer wg.Done()
+
+			supply, query, err := rpc.GetSupply(ctx)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if query != nil {
+				queryInfos = append(queryInfos, query)
+			}
+
+			if err != nil {
+				q.Logger.Error("Error querying supply", "error", err, "chain", chain.Name)
+				return
+			}
+
+			if supply != nil {
+				allSupply[chain.Name] = supply.Amount
+			}
+		}(chain, rpc.RPC)
+	}
+
+	wg.Wait()
+
+	return SupplyData{Supply: allSupply}, queryInfos
+}
+
+func (q *SupplyFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameSupply
+}
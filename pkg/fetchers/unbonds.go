@@ -2,21 +2,22 @@ package fetchers
 
 import (
 	"context"
+	"log/slog"
 	"main/pkg/config"
 	"main/pkg/constants"
 	"main/pkg/tendermint"
 	"main/pkg/types"
 	"sync"
 
-	"github.com/rs/zerolog"
 	"go.opentelemetry.io/otel/trace"
 )
 
 type UnbondsFetcher struct {
-	Logger zerolog.Logger
-	Chains []*config.Chain
-	RPCs   map[string]*tendermint.RPCWithConsumers
-	Tracer trace.Tracer
+	Logger    *slog.Logger
+	Chains    []*config.Chain
+	RPCs      map[string]*tendermint.RPCWithConsumers
+	Tracer    trace.Tracer
+	Scheduler *tendermint.Scheduler
 }
 
 type UnbondsData struct {
@@ -24,16 +25,18 @@ type UnbondsData struct {
 }
 
 func NewUnbondsFetcher(
-	logger *zerolog.Logger,
+	logger *slog.Logger,
 	chains []*config.Chain,
 	rpcs map[string]*tendermint.RPCWithConsumers,
 	tracer trace.Tracer,
+	scheduler *tendermint.Scheduler,
 ) *UnbondsFetcher {
 	return &UnbondsFetcher{
-		Logger: logger.With().Str("component", "unbonds_fetcher").Logger(),
-		Chains: chains,
-		RPCs:   rpcs,
-		Tracer: tracer,
+		Logger:    logger.With("component", "unbonds_fetcher"),
+		Chains:    chains,
+		RPCs:      rpcs,
+		Tracer:    tracer,
+		Scheduler: scheduler,
 	}
 }
 
@@ -62,6 +65,14 @@ func (q *UnbondsFetcher) Fetch(
 			wg.Add(1)
 			go func(validator string, rpc *tendermint.RPC, chain *config.Chain) {
 				defer wg.Done()
+
+				release, limiterErr := q.Scheduler.LimiterFor(chain.Name, rpc.URL).Acquire(ctx)
+				if limiterErr != nil {
+					q.Logger.Error("Error waiting for rate limiter", "error", limiterErr, "chain", chain.Name)
+					return
+				}
+				defer release()
+
 				unbondsResponse, query, err := rpc.GetUnbondsCount(validator, ctx)
 
 				mutex.Lock()
@@ -72,11 +83,12 @@ func (q *UnbondsFetcher) Fetch(
 				}
 
 				if err != nil {
-					q.Logger.Error().
-						Err(err).
-						Str("chain", chain.Name).
-						Str("address", validator).
-						Msg("Error querying validator unbonding delegations count")
+					q.Logger.Error(
+						"Error querying validator unbonding delegations count",
+						"error", err,
+						"chain", chain.Name,
+						"address", validator,
+					)
 					return
 				}
 
@@ -0,0 +1,109 @@
+package fetchers
+
+import (
+	"context"
+	"log/slog"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ValidatorConsumersFetcher struct {
+	Logger    *slog.Logger
+	Chains    []*config.Chain
+	RPCs      map[string]*tendermint.RPCWithConsumers
+	Tracer    trace.Tracer
+	Scheduler *tendermint.Scheduler
+}
+
+type ValidatorConsumersData struct {
+	Consumers map[string]map[string][]string
+}
+
+func NewValidatorConsumersFetcher(
+	logger *slog.Logger,
+	chains []*config.Chain,
+	rpcs map[string]*tendermint.RPCWithConsumers,
+	tracer trace.Tracer,
+	scheduler *tendermint.Scheduler,
+) *ValidatorConsumersFetcher {
+	return &ValidatorConsumersFetcher{
+		Logger:    logger.With("component", "validator_consumers_fetcher"),
+		Chains:    chains,
+		RPCs:      rpcs,
+		Tracer:    tracer,
+		Scheduler: scheduler,
+	}
+}
+
+func (q *ValidatorConsumersFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (q *ValidatorConsumersFetcher) Fetch(
+	ctx context.Context,
+	data ...interface{},
+) (interface{}, []*types.QueryInfo) {
+	var queryInfos []*types.QueryInfo
+
+	allConsumers := map[string]map[string][]string{}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	for _, chain := range q.Chains {
+		mutex.Lock()
+		allConsumers[chain.Name] = map[string][]string{}
+		mutex.Unlock()
+
+		rpc, _ := q.RPCs[chain.Name]
+
+		for _, validator := range chain.Validators {
+			wg.Add(1)
+
+			go func(validator string, rpc *tendermint.RPC, chain *config.Chain) {
+				defer wg.Done()
+
+				release, limiterErr := q.Scheduler.LimiterFor(chain.Name, rpc.URL).Acquire(ctx)
+				if limiterErr != nil {
+					q.Logger.Error("Error waiting for rate limiter", "error", limiterErr, "chain", chain.Name)
+					return
+				}
+				defer release()
+
+				consumerAddresses, query, err := rpc.GetValidatorConsumerAddresses(validator, ctx)
+
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				if query != nil {
+					queryInfos = append(queryInfos, query)
+				}
+
+				if err != nil {
+					q.Logger.Error(
+						"Error querying validator consumer addresses",
+						"error", err,
+						"chain", chain.Name,
+						"address", validator,
+					)
+					return
+				}
+
+				allConsumers[chain.Name][validator] = consumerAddresses
+			}(validator.Address, rpc.RPC, chain)
+		}
+	}
+
+	wg.Wait()
+
+	return ValidatorConsumersData{Consumers: allConsumers}, queryInfos
+}
+
+func (q *ValidatorConsumersFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameValidatorConsumers
+}
@@ -0,0 +1,88 @@
+package fetchers
+
+import (
+	"context"
+	"log/slog"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ValidatorsFetcher struct {
+	Logger *slog.Logger
+	Chains []*config.Chain
+	RPCs   map[string]*tendermint.RPCWithConsumers
+	Tracer trace.Tracer
+}
+
+type ValidatorsData struct {
+	Validators map[string][]*types.Validator
+}
+
+func NewValidatorsFetcher(
+	logger *slog.Logger,
+	chains []*config.Chain,
+	rpcs map[string]*tendermint.RPCWithConsumers,
+	tracer trace.Tracer,
+) *ValidatorsFetcher {
+	return &ValidatorsFetcher{
+		Logger: logger.With("component", "validators_fetcher"),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracer,
+	}
+}
+
+func (q *ValidatorsFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (q *ValidatorsFetcher) Fetch(
+	ctx context.Context,
+	data ...interface{},
+) (interface{}, []*types.QueryInfo) {
+	var queryInfos []*types.QueryInfo
+
+	allValidators := map[string][]*types.Validator{}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	for _, chain := range q.Chains {
+		rpc, _ := q.RPCs[chain.Name]
+
+		wg.Add(1)
+
+		go func(chain *config.Chain, rpc *tendermint.RPC) {
+			defer wg.Done()
+
+			validators, query, err := rpc.GetValidators(ctx)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if query != nil {
+				queryInfos = append(queryInfos, query)
+			}
+
+			if err != nil {
+				q.Logger.Error("Error querying validators", "error", err, "chain", chain.Name)
+				return
+			}
+
+			allValidators[chain.Name] = validators
+		}(chain, rpc.RPC)
+	}
+
+	wg.Wait()
+
+	return ValidatorsData{Validators: allValidators}, queryInfos
+}
+
+func (q *ValidatorsFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameValidators
+}
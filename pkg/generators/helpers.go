@@ -0,0 +1,16 @@
+package generators
+
+import "main/pkg/types"
+
+// findValidator returns the entry in validators whose address matches,
+// or nil if it isn't present - eg. because the RPC query for that
+// chain failed this cycle.
+func findValidator(validators []*types.Validator, address string) *types.Validator {
+	for _, validator := range validators {
+		if validator.Address == address {
+			return validator
+		}
+	}
+
+	return nil
+}
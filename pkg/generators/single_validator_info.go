@@ -0,0 +1,62 @@
+package generators
+
+import (
+	"log/slog"
+	"main/pkg/config"
+	"main/pkg/constants"
+	fetchersPkg "main/pkg/fetchers"
+	statePkg "main/pkg/state"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// SingleValidatorInfoGenerator exposes one info gauge per configured
+// validator, carrying its moniker as a label so dashboards can
+// join it against the other per-validator metrics without a lookup
+// table.
+type SingleValidatorInfoGenerator struct {
+	Logger *slog.Logger
+	Chains []*config.Chain
+}
+
+func NewSingleValidatorInfoGenerator(chains []*config.Chain, logger *slog.Logger) *SingleValidatorInfoGenerator {
+	return &SingleValidatorInfoGenerator{
+		Logger: logger.With("component", "single_validator_info_generator"),
+		Chains: chains,
+	}
+}
+
+func (g *SingleValidatorInfoGenerator) Generate(state *statePkg.State) []prometheus.Collector {
+	data, ok := statePkg.StateGet[fetchersPkg.ValidatorsData](state, constants.FetcherNameValidators)
+	if !ok {
+		return []prometheus.Collector{}
+	}
+
+	infoGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "validator_info",
+			Help: "Info on a single configured validator",
+		},
+		[]string{"chain", "address", "moniker"},
+	)
+
+	for _, chain := range g.Chains {
+		validators := data.Validators[chain.Name]
+
+		for _, validator := range chain.Validators {
+			found := findValidator(validators, validator.Address)
+			if found == nil {
+				g.Logger.Warn("Configured validator not found on chain", "chain", chain.Name, "address", validator.Address)
+				continue
+			}
+
+			infoGauge.With(prometheus.Labels{
+				"chain":   chain.Name,
+				"address": validator.Address,
+				"moniker": found.Moniker,
+			}).Set(1)
+		}
+	}
+
+	return []prometheus.Collector{infoGauge}
+}
@@ -0,0 +1,62 @@
+package generators
+
+import (
+	"log/slog"
+	"main/pkg/config"
+	"main/pkg/constants"
+	fetchersPkg "main/pkg/fetchers"
+	statePkg "main/pkg/state"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ValidatorActiveGenerator exposes whether a configured validator is
+// currently in its chain's active set.
+type ValidatorActiveGenerator struct {
+	Logger *slog.Logger
+	Chains []*config.Chain
+}
+
+func NewValidatorActiveGenerator(chains []*config.Chain, logger *slog.Logger) *ValidatorActiveGenerator {
+	return &ValidatorActiveGenerator{
+		Logger: logger.With("component", "validator_active_generator"),
+		Chains: chains,
+	}
+}
+
+func (g *ValidatorActiveGenerator) Generate(state *statePkg.State) []prometheus.Collector {
+	data, ok := statePkg.StateGet[fetchersPkg.ValidatorsData](state, constants.FetcherNameValidators)
+	if !ok {
+		return []prometheus.Collector{}
+	}
+
+	activeGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "validator_active",
+			Help: "Whether a configured validator is in its chain's active set",
+		},
+		[]string{"chain", "address"},
+	)
+
+	for _, chain := range g.Chains {
+		validators, ok := data.Validators[chain.Name]
+		if !ok {
+			g.Logger.Warn("No validators data for chain", "chain", chain.Name)
+			continue
+		}
+
+		for _, validator := range chain.Validators {
+			active := 0.0
+			if findValidator(validators, validator.Address) != nil {
+				active = 1
+			}
+
+			activeGauge.With(prometheus.Labels{
+				"chain":   chain.Name,
+				"address": validator.Address,
+			}).Set(active)
+		}
+	}
+
+	return []prometheus.Collector{activeGauge}
+}
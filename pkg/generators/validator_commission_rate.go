@@ -0,0 +1,62 @@
+package generators
+
+import (
+	"log/slog"
+	"main/pkg/config"
+	"main/pkg/constants"
+	fetchersPkg "main/pkg/fetchers"
+	statePkg "main/pkg/state"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ValidatorCommissionRateGenerator exposes a configured validator's
+// current commission rate.
+type ValidatorCommissionRateGenerator struct {
+	Logger *slog.Logger
+	Chains []*config.Chain
+}
+
+func NewValidatorCommissionRateGenerator(chains []*config.Chain, logger *slog.Logger) *ValidatorCommissionRateGenerator {
+	return &ValidatorCommissionRateGenerator{
+		Logger: logger.With("component", "validator_commission_rate_generator"),
+		Chains: chains,
+	}
+}
+
+func (g *ValidatorCommissionRateGenerator) Generate(state *statePkg.State) []prometheus.Collector {
+	data, ok := statePkg.StateGet[fetchersPkg.CommissionData](state, constants.FetcherNameCommission)
+	if !ok {
+		return []prometheus.Collector{}
+	}
+
+	rateGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "validator_commission_rate",
+			Help: "A configured validator's current commission rate",
+		},
+		[]string{"chain", "address"},
+	)
+
+	for _, chain := range g.Chains {
+		rates, ok := data.Rates[chain.Name]
+		if !ok {
+			g.Logger.Warn("No commission data for chain", "chain", chain.Name)
+			continue
+		}
+
+		for _, validator := range chain.Validators {
+			rate, ok := rates[validator.Address]
+			if !ok {
+				continue
+			}
+
+			rateGauge.With(prometheus.Labels{
+				"chain":   chain.Name,
+				"address": validator.Address,
+			}).Set(rate)
+		}
+	}
+
+	return []prometheus.Collector{rateGauge}
+}
@@ -0,0 +1,72 @@
+package generators
+
+import (
+	"log/slog"
+	"main/pkg/config"
+	"main/pkg/constants"
+	fetchersPkg "main/pkg/fetchers"
+	statePkg "main/pkg/state"
+	"main/pkg/types"
+	"sort"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ValidatorRankGenerator exposes a configured validator's rank in its
+// chain's active set, ordered by voting power - a quick way to see how
+// close a validator is to dropping out of the set.
+type ValidatorRankGenerator struct {
+	Logger *slog.Logger
+	Chains []*config.Chain
+}
+
+func NewValidatorRankGenerator(chains []*config.Chain, logger *slog.Logger) *ValidatorRankGenerator {
+	return &ValidatorRankGenerator{
+		Logger: logger.With("component", "validator_rank_generator"),
+		Chains: chains,
+	}
+}
+
+func (g *ValidatorRankGenerator) Generate(state *statePkg.State) []prometheus.Collector {
+	data, ok := statePkg.StateGet[fetchersPkg.ValidatorsData](state, constants.FetcherNameValidators)
+	if !ok {
+		return []prometheus.Collector{}
+	}
+
+	rankGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "validator_rank",
+			Help: "Rank of a configured validator in its chain's active set, ordered by voting power",
+		},
+		[]string{"chain", "address"},
+	)
+
+	for _, chain := range g.Chains {
+		validators := append([]*types.Validator(nil), data.Validators[chain.Name]...)
+		sort.Slice(validators, func(i, j int) bool {
+			return validators[i].VotingPower > validators[j].VotingPower
+		})
+
+		for _, validator := range chain.Validators {
+			rank := -1
+			for index, candidate := range validators {
+				if candidate.Address == validator.Address {
+					rank = index + 1
+					break
+				}
+			}
+
+			if rank == -1 {
+				g.Logger.Warn("Configured validator not found in active set", "chain", chain.Name, "address", validator.Address)
+				continue
+			}
+
+			rankGauge.With(prometheus.Labels{
+				"chain":   chain.Name,
+				"address": validator.Address,
+			}).Set(float64(rank))
+		}
+	}
+
+	return []prometheus.Collector{rankGauge}
+}
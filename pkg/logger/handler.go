@@ -0,0 +1,36 @@
+package logger
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type levelPayload struct {
+	Level string `json:"level"`
+}
+
+// LevelHandler serves GET to read the current log level and PUT
+// {"level":"debug"} to change it on a live process via the shared Level
+// var, so operators can turn on debug tracing without a restart.
+func LevelHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		writeLevel(w)
+	case http.MethodPut:
+		var payload levelPayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		Level.Set(ParseLevel(payload.Level))
+		writeLevel(w)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func writeLevel(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(levelPayload{Level: Level.Level().String()})
+}
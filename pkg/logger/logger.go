@@ -0,0 +1,51 @@
+package logger
+
+import (
+	"log/slog"
+	"main/pkg/config"
+	"os"
+	"strings"
+)
+
+// Level is shared by every logger the process creates, so swapping it via
+// the /log/level HTTP handler immediately changes verbosity everywhere
+// without a restart.
+var Level = new(slog.LevelVar)
+
+// GetDefaultLogger returns a logger usable before the config has been
+// loaded, eg. to report a config parsing error.
+func GetDefaultLogger() *slog.Logger {
+	return slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: Level}))
+}
+
+// GetLogger builds the application logger from config, preserving the
+// previous JSON/console output shape as a choice of slog.Handler.
+func GetLogger(cfg config.LogConfig) *slog.Logger {
+	Level.Set(ParseLevel(cfg.LogLevel))
+
+	opts := &slog.HandlerOptions{Level: Level}
+
+	var handler slog.Handler
+	if cfg.JSONOutput {
+		handler = slog.NewJSONHandler(os.Stderr, opts)
+	} else {
+		handler = slog.NewTextHandler(os.Stderr, opts)
+	}
+
+	return slog.New(handler)
+}
+
+// ParseLevel maps a config/API level string to a slog.Level, defaulting
+// to info for anything it doesn't recognize.
+func ParseLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
@@ -0,0 +1,58 @@
+package remotewrite
+
+import (
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/prometheus/prompb"
+)
+
+// FamiliesToTimeSeries converts a Gatherer's output into the series shape
+// remote-write expects, stamping every sample with the current time and
+// appending the configured external labels to every series. Only gauge,
+// counter and untyped families are converted; histogram and summary
+// families are skipped.
+func FamiliesToTimeSeries(families []*dto.MetricFamily, externalLabels map[string]string) []prompb.TimeSeries {
+	var series []prompb.TimeSeries
+	timestamp := time.Now().UnixMilli()
+
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			value, ok := extractValue(family.GetType(), metric)
+			if !ok {
+				continue
+			}
+
+			labels := make([]prompb.Label, 0, len(metric.GetLabel())+len(externalLabels)+1)
+			labels = append(labels, prompb.Label{Name: "__name__", Value: family.GetName()})
+
+			for _, pair := range metric.GetLabel() {
+				labels = append(labels, prompb.Label{Name: pair.GetName(), Value: pair.GetValue()})
+			}
+
+			for name, value := range externalLabels {
+				labels = append(labels, prompb.Label{Name: name, Value: value})
+			}
+
+			series = append(series, prompb.TimeSeries{
+				Labels:  labels,
+				Samples: []prompb.Sample{{Value: value, Timestamp: timestamp}},
+			})
+		}
+	}
+
+	return series
+}
+
+func extractValue(metricType dto.MetricType, metric *dto.Metric) (float64, bool) {
+	switch metricType {
+	case dto.MetricType_GAUGE:
+		return metric.GetGauge().GetValue(), true
+	case dto.MetricType_COUNTER:
+		return metric.GetCounter().GetValue(), true
+	case dto.MetricType_UNTYPED:
+		return metric.GetUntyped().GetValue(), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,157 @@
+package remotewrite
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"main/pkg/config"
+	"net/http"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/prompb"
+)
+
+// Pusher periodically gathers a Prometheus registry and ships the result
+// to one or more remote-write endpoints, so the exporter can run without
+// anything ever scraping it. Failed sends are retried with exponential
+// backoff and, failing that, kept in a bounded WAL for the next tick.
+type Pusher struct {
+	Logger *slog.Logger
+	Config config.RemoteWriteConfig
+	Client *http.Client
+	WAL    *WAL
+}
+
+func NewPusher(logger *slog.Logger, cfg config.RemoteWriteConfig) *Pusher {
+	return &Pusher{
+		Logger: logger.With("component", "remote_write_pusher"),
+		Config: cfg,
+		Client: &http.Client{Timeout: 30 * time.Second},
+		WAL:    NewWAL(cfg.WALMaxBatches),
+	}
+}
+
+// Run blocks, pushing on every configured interval until ctx is canceled.
+func (p *Pusher) Run(ctx context.Context, gatherer prometheus.Gatherer) {
+	ticker := time.NewTicker(p.Config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.tick(ctx, gatherer)
+		}
+	}
+}
+
+func (p *Pusher) tick(ctx context.Context, gatherer prometheus.Gatherer) {
+	families, err := gatherer.Gather()
+	if err != nil {
+		p.Logger.Error("Could not gather metrics for remote-write push", "error", err)
+		return
+	}
+
+	p.WAL.Push(FamiliesToTimeSeries(families, p.Config.ExternalLabels))
+
+	for _, batch := range p.WAL.Drain() {
+		var anyFailed bool
+
+		for _, endpoint := range p.Config.Endpoints {
+			if err := p.sendWithRetry(ctx, endpoint, batch); err != nil {
+				p.Logger.Error(
+					"Giving up on remote-write push to endpoint after retries",
+					"error", err,
+					"url", endpoint.URL,
+				)
+				anyFailed = true
+			}
+		}
+
+		// Re-queue at most once per batch, even if several endpoints
+		// failed, so the WAL holds a single copy of it. The next tick
+		// re-sends that copy to every endpoint, including the ones
+		// that already accepted it.
+		if anyFailed {
+			p.WAL.Requeue(batch)
+		}
+	}
+}
+
+func (p *Pusher) sendWithRetry(
+	ctx context.Context,
+	endpoint config.RemoteWriteEndpoint,
+	series []prompb.TimeSeries,
+) error {
+	backoff := 500 * time.Millisecond
+
+	var lastErr error
+	for attempt := 0; attempt <= endpoint.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+
+		if lastErr = p.send(ctx, endpoint, series); lastErr == nil {
+			return nil
+		}
+
+		p.Logger.Warn(
+			"Remote-write push attempt failed",
+			"error", lastErr,
+			"url", endpoint.URL,
+			"attempt", attempt,
+		)
+	}
+
+	return lastErr
+}
+
+func (p *Pusher) send(ctx context.Context, endpoint config.RemoteWriteEndpoint, series []prompb.TimeSeries) error {
+	data, err := (&prompb.WriteRequest{Timeseries: series}).Marshal()
+	if err != nil {
+		return fmt.Errorf("could not marshal write request: %w", err)
+	}
+
+	compressed := snappy.Encode(nil, data)
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(compressed))
+	if err != nil {
+		return fmt.Errorf("could not build remote-write request: %w", err)
+	}
+
+	httpRequest.Header.Set("Content-Type", "application/x-protobuf")
+	httpRequest.Header.Set("Content-Encoding", "snappy")
+	httpRequest.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+
+	if endpoint.TenantHeader != "" {
+		httpRequest.Header.Set("X-Scope-OrgID", endpoint.TenantHeader)
+	}
+
+	switch {
+	case endpoint.BearerToken != "":
+		httpRequest.Header.Set("Authorization", "Bearer "+endpoint.BearerToken)
+	case endpoint.BasicAuthUser != "":
+		httpRequest.SetBasicAuth(endpoint.BasicAuthUser, endpoint.BasicAuthPassword)
+	}
+
+	response, err := p.Client.Do(httpRequest)
+	if err != nil {
+		return fmt.Errorf("could not send remote-write request: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode/100 != 2 {
+		return fmt.Errorf("remote-write endpoint returned status %d", response.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package remotewrite
+
+import (
+	"sync"
+
+	"github.com/prometheus/prometheus/prompb"
+)
+
+// WAL is a small bounded in-memory write-ahead queue of pending
+// remote-write batches, so a short outage of a remote endpoint doesn't
+// lose samples gathered in the meantime. It intentionally does not spill
+// to disk: once maxBatches is exceeded, the oldest batch is dropped.
+type WAL struct {
+	mutex      sync.Mutex
+	batches    [][]prompb.TimeSeries
+	maxBatches int
+}
+
+func NewWAL(maxBatches int) *WAL {
+	return &WAL{maxBatches: maxBatches}
+}
+
+func (w *WAL) Push(series []prompb.TimeSeries) {
+	if len(series) == 0 {
+		return
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.batches = append(w.batches, series)
+
+	for w.maxBatches > 0 && len(w.batches) > w.maxBatches {
+		w.batches = w.batches[1:]
+	}
+}
+
+// Requeue puts a batch that failed to send back onto the queue, subject
+// to the same maxBatches cap as a freshly gathered one.
+func (w *WAL) Requeue(series []prompb.TimeSeries) {
+	w.Push(series)
+}
+
+// Drain returns and clears every pending batch.
+func (w *WAL) Drain() [][]prompb.TimeSeries {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	batches := w.batches
+	w.batches = nil
+	return batches
+}
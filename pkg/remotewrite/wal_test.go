@@ -0,0 +1,53 @@
+package remotewrite
+
+import (
+	"testing"
+
+	"github.com/prometheus/prometheus/prompb"
+)
+
+func series(label string) []prompb.TimeSeries {
+	return []prompb.TimeSeries{{Labels: []prompb.Label{{Name: "__name__", Value: label}}}}
+}
+
+func TestWALDrainReturnsAndClearsPendingBatches(t *testing.T) {
+	wal := NewWAL(0)
+	wal.Push(series("a"))
+	wal.Push(series("b"))
+
+	batches := wal.Drain()
+	if len(batches) != 2 {
+		t.Fatalf("expected 2 batches, got %d", len(batches))
+	}
+
+	if drainedAgain := wal.Drain(); len(drainedAgain) != 0 {
+		t.Fatalf("expected Drain to clear the queue, got %d batches left", len(drainedAgain))
+	}
+}
+
+func TestWALDropsOldestBatchOverMaxBatches(t *testing.T) {
+	wal := NewWAL(1)
+	wal.Push(series("old"))
+	wal.Push(series("new"))
+
+	batches := wal.Drain()
+	if len(batches) != 1 {
+		t.Fatalf("expected the queue capped at 1 batch, got %d", len(batches))
+	}
+
+	if batches[0][0].Labels[0].Value != "new" {
+		t.Fatalf("expected the oldest batch to have been dropped, got %q", batches[0][0].Labels[0].Value)
+	}
+}
+
+func TestWALRequeueDoesNotDuplicateWithinOneDrain(t *testing.T) {
+	wal := NewWAL(0)
+	batch := series("retry")
+
+	wal.Requeue(batch)
+
+	batches := wal.Drain()
+	if len(batches) != 1 {
+		t.Fatalf("expected a single requeued batch, got %d", len(batches))
+	}
+}
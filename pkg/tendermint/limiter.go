@@ -0,0 +1,78 @@
+package tendermint
+
+import (
+	"context"
+	"main/pkg/constants"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+)
+
+// Limiter bounds how fast and how concurrently a single RPC endpoint is
+// hit: a token bucket caps queries per second, and a semaphore caps how
+// many can be in flight at once.
+type Limiter struct {
+	tokens    *rate.Limiter
+	semaphore chan struct{}
+
+	waitTime prometheus.Histogram
+	inFlight prometheus.Gauge
+	rejected prometheus.Counter
+}
+
+func NewLimiter(chain string, url string, qps float64, burst int, maxInFlight int) *Limiter {
+	labels := prometheus.Labels{"chain": chain, "url": url}
+
+	return &Limiter{
+		tokens:    rate.NewLimiter(rate.Limit(qps), burst),
+		semaphore: make(chan struct{}, maxInFlight),
+		waitTime: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:        constants.MetricsPrefix + "rpc_wait_seconds",
+			Help:        "Time an RPC query spent waiting for the rate limiter before dispatch",
+			ConstLabels: labels,
+			Buckets:     prometheus.DefBuckets,
+		}),
+		inFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name:        constants.MetricsPrefix + "rpc_in_flight",
+			Help:        "Count of RPC queries currently in flight",
+			ConstLabels: labels,
+		}),
+		rejected: prometheus.NewCounter(prometheus.CounterOpts{
+			Name:        constants.MetricsPrefix + "rpc_rejected_total",
+			Help:        "Count of RPC queries rejected or timed out waiting for the limiter",
+			ConstLabels: labels,
+		}),
+	}
+}
+
+func (l *Limiter) Metrics() []prometheus.Collector {
+	return []prometheus.Collector{l.waitTime, l.inFlight, l.rejected}
+}
+
+// Acquire blocks until the rate limiter allows dispatch and a semaphore
+// slot is free, or ctx is canceled - whichever comes first. The
+// returned release func must be called once the RPC call completes.
+func (l *Limiter) Acquire(ctx context.Context) (release func(), err error) {
+	start := time.Now()
+
+	if err := l.tokens.Wait(ctx); err != nil {
+		l.rejected.Inc()
+		return nil, err
+	}
+
+	select {
+	case l.semaphore <- struct{}{}:
+	case <-ctx.Done():
+		l.rejected.Inc()
+		return nil, ctx.Err()
+	}
+
+	l.waitTime.Observe(time.Since(start).Seconds())
+	l.inFlight.Inc()
+
+	return func() {
+		l.inFlight.Dec()
+		<-l.semaphore
+	}, nil
+}
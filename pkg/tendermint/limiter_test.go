@@ -0,0 +1,55 @@
+package tendermint
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLimiterAcquireReleaseAllowsReuse(t *testing.T) {
+	limiter := NewLimiter("chain", "http://localhost", 100, 1, 1)
+
+	release, err := limiter.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	release()
+
+	if _, err := limiter.Acquire(context.Background()); err != nil {
+		t.Fatalf("expected second acquire to succeed after release, got %v", err)
+	}
+}
+
+func TestLimiterAcquireBlocksUntilSemaphoreFrees(t *testing.T) {
+	limiter := NewLimiter("chain", "http://localhost", 1000, 1000, 1)
+
+	release, err := limiter.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := limiter.Acquire(ctx); err == nil {
+		t.Fatal("expected second acquire to block and time out while the slot is held")
+	}
+
+	release()
+
+	if _, err := limiter.Acquire(context.Background()); err != nil {
+		t.Fatalf("expected acquire to succeed once the slot is released, got %v", err)
+	}
+}
+
+func TestLimiterAcquireRespectsCanceledContext(t *testing.T) {
+	limiter := NewLimiter("chain", "http://localhost", 0.001, 1, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := limiter.Acquire(ctx); err == nil {
+		t.Fatal("expected acquire to fail immediately on an already-canceled context")
+	}
+}
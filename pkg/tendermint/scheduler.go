@@ -0,0 +1,85 @@
+package tendermint
+
+import (
+	"main/pkg/config"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Scheduler hands out a Limiter per chain+endpoint URL, so every call
+// that acquires one for the same chain and URL - regardless of which
+// fetcher issued it - shares one token bucket and in-flight cap. Calls
+// made without acquiring a Limiter are not limited.
+type Scheduler struct {
+	mutex    sync.Mutex
+	limiters map[string]*Limiter
+	config   config.RateLimitConfig
+}
+
+func NewScheduler(cfg config.RateLimitConfig) *Scheduler {
+	return &Scheduler{limiters: map[string]*Limiter{}, config: cfg}
+}
+
+func (s *Scheduler) LimiterFor(chain string, url string) *Limiter {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := chain + "|" + url
+	if limiter, ok := s.limiters[key]; ok {
+		return limiter
+	}
+
+	qps, burst, maxInFlight := s.resolve(chain, url)
+	limiter := NewLimiter(chain, url, qps, burst, maxInFlight)
+	s.limiters[key] = limiter
+
+	return limiter
+}
+
+func (s *Scheduler) Metrics() []prometheus.Collector {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var metrics []prometheus.Collector
+	for _, limiter := range s.limiters {
+		metrics = append(metrics, limiter.Metrics()...)
+	}
+
+	return metrics
+}
+
+func (s *Scheduler) resolve(chain string, url string) (qps float64, burst int, maxInFlight int) {
+	qps, burst, maxInFlight = s.config.DefaultQPS, s.config.DefaultBurst, s.config.DefaultMaxInFlight
+
+	if override, ok := s.config.PerChain[chain]; ok {
+		qps, burst, maxInFlight = applyOverride(qps, burst, maxInFlight, override)
+	}
+
+	if override, ok := s.config.PerEndpoint[url]; ok {
+		qps, burst, maxInFlight = applyOverride(qps, burst, maxInFlight, override)
+	}
+
+	return qps, burst, maxInFlight
+}
+
+func applyOverride(
+	qps float64,
+	burst int,
+	maxInFlight int,
+	override config.RateLimitOverride,
+) (float64, int, int) {
+	if override.QPS > 0 {
+		qps = override.QPS
+	}
+
+	if override.Burst > 0 {
+		burst = override.Burst
+	}
+
+	if override.MaxInFlight > 0 {
+		maxInFlight = override.MaxInFlight
+	}
+
+	return qps, burst, maxInFlight
+}